@@ -0,0 +1,245 @@
+package confluence
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var attachmentsCmd = &cobra.Command{
+	Use:   "attachments",
+	Short: "Manage Confluence page attachments",
+	Long: `List, download, and upload files attached to Confluence pages.
+
+Uploading a file with the same name as an existing attachment adds a new version of it.
+
+Available Commands:
+- list: List the attachments of a page
+- download: Download attachments to a local directory
+- upload: Upload files to a page
+
+Examples:
+  # List attachments
+  markcli atlassian confluence attachments list --page 123456
+
+  # Download a single file
+  markcli atlassian confluence attachments download --page 123456 diagram.png
+
+  # Download everything into a directory
+  markcli atlassian confluence attachments download --page 123456 --all --dir out/
+
+  # Upload generated diagrams
+  markcli atlassian confluence attachments upload --page 123456 build/*.svg --comment "CI build 42"`,
+}
+
+var attachmentsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the attachments of a Confluence page",
+	Long: `List the attachments of a Confluence page with their type, size, and version.
+	
+Example:
+  markcli atlassian confluence attachments list --page 123456`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("page")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		page, err := client.AtlassianConfluenceGetPage(pageID)
+		if err != nil {
+			return fmt.Errorf("failed to get page: %w", err)
+		}
+
+		attachments, err := client.AtlassianConfluenceGetPageAttachments(pageID)
+		if err != nil {
+			return fmt.Errorf("failed to get attachments: %w", err)
+		}
+
+		formatter := formatting.AtlassianConfluenceCreateAttachmentsFormatter(page.Title, cfg.BaseURL, attachments)
+		rendering.PrintMarkdown(formatter.AtlassianConfluenceFormatAttachmentsAsMarkdown())
+		return nil
+	},
+}
+
+var attachmentsDownloadCmd = &cobra.Command{
+	Use:   "download [NAME...]",
+	Short: "Download attachments of a Confluence page",
+	Long: `Download attachments of a Confluence page by name, or all of them with --all.
+Files are streamed to disk, with a progress indicator when running in a terminal.
+	
+Examples:
+  markcli atlassian confluence attachments download --page 123456 diagram.png
+  markcli atlassian confluence attachments download --page 123456 --all --dir out/`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("page")
+		all, _ := cmd.Flags().GetBool("all")
+		dir, _ := cmd.Flags().GetString("dir")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if len(args) == 0 && !all {
+			return fmt.Errorf("specify attachment names or use --all")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		attachments, err := client.AtlassianConfluenceGetPageAttachments(pageID)
+		if err != nil {
+			return fmt.Errorf("failed to get attachments: %w", err)
+		}
+
+		selected := attachments
+		if !all {
+			byName := make(map[string]types.AtlassianConfluenceAttachment)
+			for _, attachment := range attachments {
+				byName[attachment.Title] = attachment
+			}
+			selected = nil
+			for _, name := range args {
+				attachment, ok := byName[name]
+				if !ok {
+					return fmt.Errorf("page %s has no attachment named %s", pageID, name)
+				}
+				selected = append(selected, attachment)
+			}
+		}
+
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", dir, err)
+		}
+
+		for _, attachment := range selected {
+			path := filepath.Join(dir, filepath.Base(attachment.Title))
+			size, err := downloadAttachment(client, attachment, path)
+			if err != nil {
+				return fmt.Errorf("failed to download %s: %w", attachment.Title, err)
+			}
+			fmt.Printf("Downloaded %s (%s)\n", path, util.FormatBytes(size))
+		}
+		return nil
+	},
+}
+
+var attachmentsUploadCmd = &cobra.Command{
+	Use:   "upload FILE...",
+	Short: "Upload files to a Confluence page",
+	Long: `Upload files to a Confluence page. A file whose name matches an existing
+attachment is uploaded as a new version of that attachment.
+	
+Example:
+  markcli atlassian confluence attachments upload --page 123456 diagram.png --comment "Updated topology"`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("page")
+		comment, _ := cmd.Flags().GetString("comment")
+		minorEdit, _ := cmd.Flags().GetBool("minor-edit")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		for _, path := range args {
+			attachment, err := uploadAttachment(client, pageID, path, comment, minorEdit)
+			if err != nil {
+				return fmt.Errorf("failed to upload %s: %w", path, err)
+			}
+			fmt.Printf("Uploaded %s as version %d (ID: %s)\n", attachment.Title, attachment.Version.Number, attachment.ID)
+		}
+		return nil
+	},
+}
+
+// downloadAttachment streams an attachment to path and returns the number of bytes written.
+// A partially written file is removed when the download fails.
+func downloadAttachment(client *atlassian.Client, attachment types.AtlassianConfluenceAttachment, path string) (int64, error) {
+	content, size, err := client.AtlassianConfluenceDownloadAttachment(attachment)
+	if err != nil {
+		return 0, err
+	}
+	defer content.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+
+	progress := rendering.NewProgress("Downloading "+attachment.Title, size)
+	written, err := io.Copy(io.MultiWriter(file, progress), content)
+	progress.Done()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return 0, err
+	}
+	return written, nil
+}
+
+// uploadAttachment uploads a local file to a page, reporting progress as it is sent
+func uploadAttachment(client *atlassian.Client, pageID, path, comment string, minorEdit bool) (*types.AtlassianConfluenceAttachment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	name := filepath.Base(path)
+	progress := rendering.NewProgress("Uploading "+name, info.Size())
+	attachment, err := client.AtlassianConfluenceUploadAttachment(pageID, name, io.TeeReader(file, progress), comment, minorEdit)
+	progress.Done()
+	return attachment, err
+}
+
+func init() {
+	Cmd.AddCommand(attachmentsCmd)
+	attachmentsCmd.AddCommand(attachmentsListCmd)
+	attachmentsCmd.AddCommand(attachmentsDownloadCmd)
+	attachmentsCmd.AddCommand(attachmentsUploadCmd)
+
+	for _, c := range []*cobra.Command{attachmentsListCmd, attachmentsDownloadCmd, attachmentsUploadCmd} {
+		c.Flags().String("page", "", "Page ID")
+		c.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+		c.MarkFlagRequired("page")
+	}
+
+	attachmentsDownloadCmd.Flags().Bool("all", false, "Download all attachments of the page")
+	attachmentsDownloadCmd.Flags().String("dir", ".", "Directory to save the files to")
+
+	attachmentsUploadCmd.Flags().String("comment", "", "Comment to store with the uploaded version")
+	attachmentsUploadCmd.Flags().Bool("minor-edit", true, "Upload without notifying page watchers")
+}
@@ -0,0 +1,178 @@
+package confluence
+
+import (
+	"fmt"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+
+	"github.com/spf13/cobra"
+)
+
+var blogsCmd = &cobra.Command{
+	Use:     "blogs",
+	Aliases: []string{"blogposts"},
+	Short:   "Work with Confluence blog posts",
+	Long: `List, view, create, and export Confluence blog posts.
+
+Available Commands:
+- list: List the blog posts of a space
+- get: Get a blog post with its content
+- create: Create a blog post from markdown or a template
+- export: Export a blog post as markdown with YAML frontmatter
+
+Examples:
+  # List recent blog posts in a space
+  markcli atlassian confluence blogs list --space ENG --limit 10
+
+  # Read a blog post
+  markcli atlassian confluence blogs get --id 123456
+
+  # Publish release notes
+  markcli atlassian confluence blogs create --space ENG --title "Release 1.4" --file notes.md`,
+}
+
+var blogsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the blog posts of a space",
+	Long: `List the blog posts of a space, newest first.
+	
+Example:
+  markcli atlassian confluence blogs list --space ENG --limit 10`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceKey, _ := cmd.Flags().GetString("space")
+		limit, _ := cmd.Flags().GetInt("limit")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		space, err := client.AtlassianConfluenceGetSpaceByKey(spaceKey)
+		if err != nil {
+			return fmt.Errorf("failed to get space: %w", err)
+		}
+
+		posts, err := client.AtlassianConfluenceListBlogPosts(space.ID, limit)
+		if err != nil {
+			return fmt.Errorf("failed to list blog posts: %w", err)
+		}
+
+		authors := make(map[string]string)
+		for _, post := range posts {
+			if _, ok := authors[post.AuthorID]; !ok && post.AuthorID != "" {
+				authors[post.AuthorID] = client.AtlassianConfluenceUserDisplayName(post.AuthorID)
+			}
+		}
+
+		formatter := formatting.AtlassianConfluenceCreateBlogPostsFormatter(cfg.BaseURL, posts, authors)
+		output := fmt.Sprintf("# Blog Posts in Space %s\n\n", space.Key)
+		output += formatter.AtlassianConfluenceFormatBlogPostsAsMarkdown()
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+var blogsGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get a blog post by ID",
+	Long: `Get a Confluence blog post by ID using Confluence API v2.
+	
+Example:
+  markcli atlassian confluence blogs get --id 123456`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		postRef, _ := cmd.Flags().GetString("id")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		return PrintBlogPost(siteName, postRef)
+	},
+}
+
+// PrintBlogPost prints a blog post as markdown. postRef may be a blog post ID or URL.
+func PrintBlogPost(siteName, postRef string) error {
+	// Get Atlassian configuration
+	cfg, err := config.GetAtlassianConfig(siteName)
+	if err != nil {
+		return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+	}
+
+	// Create client
+	client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+	// Blog post URLs resolve like page URLs
+	postID, err := client.AtlassianConfluenceResolvePageID(postRef)
+	if err != nil {
+		return err
+	}
+
+	post, err := client.AtlassianConfluenceGetBlogPost(postID)
+	if err != nil {
+		return fmt.Errorf("failed to get blog post: %w", err)
+	}
+	if post.AuthorID != "" {
+		post.Version.Author.DisplayName = client.AtlassianConfluenceUserDisplayName(post.AuthorID)
+	}
+
+	formatter := formatting.AtlassianConfluenceCreatePageDetailsFormatter(*post)
+
+	// Print the formatted output using Glamour
+	rendering.PrintMarkdown(formatter.AtlassianConfluenceFormatPageDetailsAsMarkdown())
+	return nil
+}
+
+var blogsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a blog post from markdown or a template",
+	Long: `Create a Confluence blog post from a markdown file, a Confluence template,
+or nothing at all. Variables and frontmatter work as for 'pages create'.
+	
+Examples:
+  markcli atlassian confluence blogs create --space ENG --title "Release 1.4" --file notes.md
+  markcli atlassian confluence blogs create --file weekly.md --var week=42`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return createContent(cmd, "blogpost")
+	},
+}
+
+var blogsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a blog post as markdown with frontmatter",
+	Long: `Export a Confluence blog post as plain markdown preceded by YAML frontmatter.
+
+The output is written to stdout unless --output is given.
+	
+Example:
+  markcli atlassian confluence blogs export --id 123456 --output release-1.4.md`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return exportContent(cmd, "blogpost")
+	},
+}
+
+func init() {
+	Cmd.AddCommand(blogsCmd)
+	blogsCmd.AddCommand(blogsListCmd)
+	blogsCmd.AddCommand(blogsGetCmd)
+	blogsCmd.AddCommand(blogsCreateCmd)
+	blogsCmd.AddCommand(blogsExportCmd)
+
+	blogsListCmd.Flags().String("space", "", "Space key to list blog posts from")
+	blogsListCmd.Flags().IntP("limit", "l", 25, "Maximum number of blog posts to show (0 for all)")
+	blogsListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	blogsListCmd.MarkFlagRequired("space")
+
+	blogsGetCmd.Flags().String("id", "", "Blog post ID or URL")
+	blogsGetCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	blogsGetCmd.MarkFlagRequired("id")
+
+	addCreateFlags(blogsCreateCmd)
+	addExportFlags(blogsExportCmd)
+}
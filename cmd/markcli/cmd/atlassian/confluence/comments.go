@@ -0,0 +1,305 @@
+package confluence
+
+import (
+	"fmt"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var commentsCmd = &cobra.Command{
+	Use:   "comments",
+	Short: "Manage Confluence page comments",
+	Long: `List, add, reply to, and resolve footer and inline comments on Confluence pages.
+
+Comment bodies are written in markdown and converted to Atlassian Document Format.
+
+Available Commands:
+- list: List all footer and inline comment threads on a page
+- add: Add a footer comment, or an inline comment anchored to a text selection
+- reply: Reply to an existing comment
+- resolve: Resolve or reopen an inline comment
+
+Examples:
+  # List comment threads
+  markcli atlassian confluence comments list --page 123456
+
+  # Add a footer comment from a file
+  markcli atlassian confluence comments add --page 123456 --file comment.md
+
+  # Add an inline comment on the second occurrence of a phrase
+  markcli atlassian confluence comments add --page 123456 --inline \
+    --selection "eventual consistency" --match-index 1 --text "Which store is this?"
+
+  # Reply to a comment
+  markcli atlassian confluence comments reply --comment 98765 --text "Fixed, thanks!"
+
+  # Resolve an inline comment
+  markcli atlassian confluence comments resolve --comment 98765`,
+}
+
+var commentsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List comments on a Confluence page",
+	Long: `List all footer and inline comments on a Confluence page, including replies.
+	
+Example:
+  markcli atlassian confluence comments list --page 123456`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("page")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		// Get comments
+		footer, inline, err := client.AtlassianConfluenceGetPageComments(pageID)
+		if err != nil {
+			return fmt.Errorf("failed to get comments: %w", err)
+		}
+
+		// Format the comments
+		formatter := formatting.AtlassianConfluenceCreateCommentsFormatter(footer, inline)
+		output := formatter.AtlassianConfluenceFormatCommentsAsMarkdown()
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+var commentsAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a comment to a Confluence page",
+	Long: `Add a footer comment to a Confluence page, or an inline comment anchored to a text selection.
+
+The comment body is read from --text or --file (use "-" for stdin) and converted from markdown.
+
+Examples:
+  # Footer comment
+  markcli atlassian confluence comments add --page 123456 --text "Looks good to me"
+
+  # Inline comment
+  markcli atlassian confluence comments add --page 123456 --inline --selection "retry budget" --file note.md`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("page")
+		inline, _ := cmd.Flags().GetBool("inline")
+		selection, _ := cmd.Flags().GetString("selection")
+		matchIndex, _ := cmd.Flags().GetInt("match-index")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if inline && selection == "" {
+			return fmt.Errorf("--selection is required for inline comments")
+		}
+
+		body, err := readCommentBody(cmd)
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		opts := types.AtlassianConfluenceCreateCommentOptions{
+			PageID: pageID,
+			Inline: inline,
+			Body:   body,
+		}
+
+		// Inline comments must say which occurrence of the selection they refer to
+		if inline {
+			page, err := client.AtlassianConfluenceGetPage(pageID)
+			if err != nil {
+				return fmt.Errorf("failed to get page: %w", err)
+			}
+			doc, err := types.ParseDocument(page.Body.AtlasDocFormat.Value)
+			if err != nil {
+				return fmt.Errorf("failed to parse page body: %w", err)
+			}
+
+			count := strings.Count(doc.AtlassianDocumentPlainText(), selection)
+			if count == 0 {
+				return fmt.Errorf("selection %q not found on page %s", selection, pageID)
+			}
+			if matchIndex < 0 || matchIndex >= count {
+				return fmt.Errorf("--match-index %d is out of range: selection occurs %d times", matchIndex, count)
+			}
+
+			opts.TextSelection = selection
+			opts.MatchCount = count
+			opts.MatchIndex = matchIndex
+		}
+
+		comment, err := client.AtlassianConfluenceCreateComment(opts)
+		if err != nil {
+			return fmt.Errorf("failed to add comment: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Added %s comment %s to page %s\n", commentKind(comment), comment.ID, pageID))
+		return nil
+	},
+}
+
+var commentsReplyCmd = &cobra.Command{
+	Use:   "reply",
+	Short: "Reply to a Confluence comment",
+	Long: `Reply to a footer or inline comment. The reply body is read from --text or --file
+(use "-" for stdin) and converted from markdown.
+	
+Example:
+  markcli atlassian confluence comments reply --comment 98765 --file reply.md`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID, _ := cmd.Flags().GetString("comment")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		body, err := readCommentBody(cmd)
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		// Replies go to the same endpoint as their parent
+		parent, err := client.AtlassianConfluenceGetComment(commentID)
+		if err != nil {
+			return fmt.Errorf("failed to get comment: %w", err)
+		}
+
+		reply, err := client.AtlassianConfluenceCreateComment(types.AtlassianConfluenceCreateCommentOptions{
+			ParentCommentID: commentID,
+			Inline:          parent.Inline,
+			Body:            body,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reply to comment: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Added reply %s to %s comment %s\n", reply.ID, commentKind(parent), commentID))
+		return nil
+	},
+}
+
+var commentsResolveCmd = &cobra.Command{
+	Use:   "resolve",
+	Short: "Resolve or reopen an inline comment",
+	Long: `Resolve an inline comment thread, or reopen it with --reopen.
+	
+Examples:
+  markcli atlassian confluence comments resolve --comment 98765
+  markcli atlassian confluence comments resolve --comment 98765 --reopen`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID, _ := cmd.Flags().GetString("comment")
+		reopen, _ := cmd.Flags().GetBool("reopen")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		comment, err := client.AtlassianConfluenceResolveInlineComment(commentID, !reopen)
+		if err != nil {
+			return fmt.Errorf("failed to update comment: %w", err)
+		}
+
+		status := comment.ResolutionStatus
+		if status == "" {
+			status = "resolved"
+			if reopen {
+				status = "reopened"
+			}
+		}
+		rendering.PrintMarkdown(fmt.Sprintf("Comment %s is now %s\n", commentID, status))
+		return nil
+	},
+}
+
+// readCommentBody reads the markdown body from --text or --file and converts it to ADF JSON
+func readCommentBody(cmd *cobra.Command) (string, error) {
+	text, _ := cmd.Flags().GetString("text")
+	filePath, _ := cmd.Flags().GetString("file")
+
+	markdown, err := util.ReadTextInput(text, filePath)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(markdown) == "" {
+		return "", fmt.Errorf("comment body is empty: use --text or --file")
+	}
+
+	body, err := types.AtlassianDocumentConvertMarkdownToJSON(markdown)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert markdown: %w", err)
+	}
+	return body, nil
+}
+
+// commentKind describes whether a comment is a footer or inline comment
+func commentKind(comment *types.AtlassianConfluenceComment) string {
+	if comment.Inline {
+		return "inline"
+	}
+	return "footer"
+}
+
+func init() {
+	Cmd.AddCommand(commentsCmd)
+	commentsCmd.AddCommand(commentsListCmd)
+	commentsCmd.AddCommand(commentsAddCmd)
+	commentsCmd.AddCommand(commentsReplyCmd)
+	commentsCmd.AddCommand(commentsResolveCmd)
+
+	commentsListCmd.Flags().String("page", "", "Page ID to list comments for")
+	commentsListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	commentsListCmd.MarkFlagRequired("page")
+
+	commentsAddCmd.Flags().String("page", "", "Page ID to comment on")
+	commentsAddCmd.Flags().StringP("text", "t", "", "Comment body in markdown")
+	commentsAddCmd.Flags().StringP("file", "f", "", "Markdown file with the comment body (\"-\" for stdin)")
+	commentsAddCmd.Flags().Bool("inline", false, "Add an inline comment anchored to --selection")
+	commentsAddCmd.Flags().String("selection", "", "Text on the page to anchor an inline comment to")
+	commentsAddCmd.Flags().Int("match-index", 0, "Which occurrence of --selection to anchor to (0-based)")
+	commentsAddCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	commentsAddCmd.MarkFlagRequired("page")
+
+	commentsReplyCmd.Flags().String("comment", "", "Comment ID to reply to")
+	commentsReplyCmd.Flags().StringP("text", "t", "", "Reply body in markdown")
+	commentsReplyCmd.Flags().StringP("file", "f", "", "Markdown file with the reply body (\"-\" for stdin)")
+	commentsReplyCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	commentsReplyCmd.MarkFlagRequired("comment")
+
+	commentsResolveCmd.Flags().String("comment", "", "Inline comment ID to resolve")
+	commentsResolveCmd.Flags().Bool("reopen", false, "Reopen the comment instead of resolving it")
+	commentsResolveCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	commentsResolveCmd.MarkFlagRequired("comment")
+}
@@ -0,0 +1,106 @@
+package confluence
+
+import (
+	"fmt"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var contentCmd = &cobra.Command{
+	Use:   "content",
+	Short: "Work with other Confluence content types",
+	Long: `Work with Confluence content types beyond pages and blog posts,
+such as whiteboards, databases, folders, and smart links (embeds).
+
+Available Commands:
+- list: List content of these types in a space
+
+Examples:
+  # List all whiteboards, databases, folders, and embeds in a space
+  markcli atlassian confluence content list --space ENG
+
+  # List only whiteboards
+  markcli atlassian confluence content list --space ENG --type whiteboard`,
+}
+
+var contentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List whiteboards, databases, folders, and embeds",
+	Long: `List whiteboards, databases, folders, and embeds with their space,
+last modification, and a link to open them.
+	
+Examples:
+  markcli atlassian confluence content list --space ENG
+  markcli atlassian confluence content list --space ENG --type database,folder`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceKey, _ := cmd.Flags().GetString("space")
+		contentTypes, _ := cmd.Flags().GetStringSlice("type")
+		limit, _ := cmd.Flags().GetInt("limit")
+		page, _ := cmd.Flags().GetInt("page")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		// Calculate start position for pagination
+		startAt := (page - 1) * limit
+
+		results, err := client.AtlassianConfluenceSearchPages(types.AtlassianConfluenceSearchOptions{
+			SpaceKey:  spaceKey,
+			Types:     contentTypes,
+			StartAt:   startAt,
+			Limit:     limit,
+			SortBy:    "lastModified",
+			SortOrder: "desc",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list content: %w", err)
+		}
+
+		heading := "# Content\n\n"
+		if spaceKey != "" {
+			heading = fmt.Sprintf("# Content in Space %s\n\n", spaceKey)
+		}
+		formatter := formatting.AtlassianConfluenceCreateContentTableFormatter(cfg.BaseURL, results.Results)
+		output := heading + formatter.AtlassianConfluenceFormatContentAsMarkdown()
+
+		// Add pagination info
+		if len(results.Results) > 0 {
+			output += fmt.Sprintf("\nShowing %d-%d of %d results\n",
+				startAt+1,
+				util.Min(startAt+len(results.Results), results.TotalSize),
+				results.TotalSize,
+			)
+		}
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(contentCmd)
+	contentCmd.AddCommand(contentListCmd)
+
+	contentListCmd.Flags().StringP("space", "s", "", "Space key to list content from")
+	contentListCmd.Flags().StringSlice("type", []string{"whiteboard", "database", "folder", "embed"},
+		fmt.Sprintf("Content types to list (%s)", strings.Join(atlassian.AtlassianConfluenceContentTypes, "|")))
+	contentListCmd.Flags().IntP("limit", "l", 100, "Number of results per page")
+	contentListCmd.Flags().IntP("page", "p", 1, "Page number")
+	contentListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
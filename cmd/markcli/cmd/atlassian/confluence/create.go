@@ -0,0 +1,274 @@
+package confluence
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"regexp"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	"markcli/internal/markdown"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+// storagePlaceholderPattern matches instructional placeholders in storage format templates
+var storagePlaceholderPattern = regexp.MustCompile(`(?s)<ac:placeholder[^>]*>(.*?)</ac:placeholder>`)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a page from markdown or a template",
+	Long: `Create a Confluence page from a markdown file, a Confluence template, or nothing at all.
+
+Variables given with --var fill in the template:
+- Placeholders (instructional text) in Confluence templates are replaced when
+  their text matches a variable name
+- {{name}} variables are replaced in Confluence templates, markdown files, and the title
+
+A markdown file may start with YAML frontmatter providing the title, space,
+parent_id, and labels, such as the files written by 'pages export'.
+	
+Examples:
+  # Create a page from markdown
+  markcli atlassian confluence pages create --space ENG --title "Release 1.4" --file notes.md
+
+  # Create an ADR from a space template
+  markcli atlassian confluence pages create --space ARCH --template 12345678 \
+    --title "ADR {{number}}: {{title}}" --var number=42 --var "title=Use Postgres" \
+    --var "Decision owner=Alice"
+
+  # Create a page from a local markdown template with frontmatter
+  markcli atlassian confluence pages create --file templates/incident.md --var date=2026-10-18`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return createContent(cmd, "page")
+	},
+}
+
+// createContent creates a page or blog post from the flags of a create command
+func createContent(cmd *cobra.Command, contentType string) error {
+	spaceKey, _ := cmd.Flags().GetString("space")
+	parentRef, _ := cmd.Flags().GetString("parent")
+	title, _ := cmd.Flags().GetString("title")
+	filePath, _ := cmd.Flags().GetString("file")
+	templateID, _ := cmd.Flags().GetString("template")
+	varPairs, _ := cmd.Flags().GetStringArray("var")
+	labels, _ := cmd.Flags().GetStringSlice("labels")
+	status, _ := cmd.Flags().GetString("status")
+	siteName, _ := cmd.Flags().GetString("site")
+
+	if filePath != "" && templateID != "" {
+		return fmt.Errorf("use either --file or --template, not both")
+	}
+
+	vars, err := util.ParseKeyValuePairs(varPairs)
+	if err != nil {
+		return err
+	}
+
+	// Get Atlassian configuration
+	cfg, err := config.GetAtlassianConfig(siteName)
+	if err != nil {
+		return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+	}
+
+	// Create client
+	client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+	opts := types.AtlassianConfluenceCreatePageOptions{Status: status}
+	var missing []string
+
+	switch {
+	case templateID != "":
+		template, err := client.AtlassianConfluenceGetTemplate(templateID)
+		if err != nil {
+			return fmt.Errorf("failed to get template: %w", err)
+		}
+		opts.Body, opts.Representation, missing, err = expandConfluenceTemplate(template, vars)
+		if err != nil {
+			return err
+		}
+		labels = append(labels, types.AtlassianConfluenceLabelNames(template.Labels)...)
+	case filePath != "":
+		content, err := util.ReadTextInput("", filePath)
+		if err != nil {
+			return err
+		}
+
+		var meta types.AtlassianConfluencePageFrontmatter
+		body, err := markdown.SplitFrontmatter(content, &meta)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+		if title == "" {
+			title = meta.Title
+		}
+		if spaceKey == "" {
+			spaceKey = meta.Space
+		}
+		if parentRef == "" && contentType == "page" {
+			parentRef = meta.ParentID
+		}
+		labels = append(labels, meta.Labels...)
+
+		body, missing = util.ExpandTemplateVariables(body, vars)
+		opts.Body, err = types.AtlassianDocumentConvertMarkdownToJSON(stripTitleHeading(body, title, vars))
+		if err != nil {
+			return fmt.Errorf("failed to convert markdown: %w", err)
+		}
+	default:
+		opts.Body, err = types.AtlassianDocumentConvertMarkdownToJSON("")
+		if err != nil {
+			return fmt.Errorf("failed to convert markdown: %w", err)
+		}
+	}
+
+	var titleMissing []string
+	opts.Title, titleMissing = util.ExpandTemplateVariables(title, vars)
+	missing = append(titleMissing, missing...)
+	if opts.Title == "" {
+		return fmt.Errorf("a title is required: use --title or a title in the frontmatter")
+	}
+	if len(missing) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: no value for %s\n", strings.Join(uniqueStrings(missing), ", "))
+	}
+
+	// Resolve the parent and the space it belongs to
+	if parentRef != "" {
+		opts.ParentID, err = client.AtlassianConfluenceResolvePageID(parentRef)
+		if err != nil {
+			return err
+		}
+	}
+	switch {
+	case spaceKey != "":
+		space, err := client.AtlassianConfluenceGetSpaceByKey(spaceKey)
+		if err != nil {
+			return fmt.Errorf("failed to get space: %w", err)
+		}
+		opts.SpaceID = space.ID
+	case opts.ParentID != "":
+		parent, err := client.AtlassianConfluenceGetPage(opts.ParentID)
+		if err != nil {
+			return fmt.Errorf("failed to get parent page: %w", err)
+		}
+		opts.SpaceID = parent.SpaceId
+	default:
+		return fmt.Errorf("a space is required: use --space, --parent, or a space in the frontmatter")
+	}
+
+	var page *types.AtlassianConfluencePageDetails
+	if contentType == "blogpost" {
+		page, err = client.AtlassianConfluenceCreateBlogPost(opts)
+	} else {
+		page, err = client.AtlassianConfluenceCreatePage(opts)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", contentKind(contentType), err)
+	}
+
+	if labels = normalizeLabels(labels); len(labels) > 0 {
+		if _, err := client.AtlassianConfluenceAddLabels(page.ID, labels); err != nil {
+			return fmt.Errorf("created %s %s but failed to add labels: %w", contentKind(contentType), page.ID, err)
+		}
+	}
+
+	fmt.Printf("Created %s %s (ID: %s)\n", contentKind(contentType), page.Title, page.ID)
+	if page.Links.WebUI != "" {
+		fmt.Printf("%s/wiki%s\n", cfg.BaseURL, page.Links.WebUI)
+	}
+	return nil
+}
+
+// contentKind returns a human-readable name for a content type
+func contentKind(contentType string) string {
+	if contentType == "blogpost" {
+		return "blog post"
+	}
+	return "page"
+}
+
+// expandConfluenceTemplate fills in a template's body, returning the body, its representation,
+// and the placeholders and variables left without a value
+func expandConfluenceTemplate(template *types.AtlassianConfluenceTemplate, vars map[string]string) (string, string, []string, error) {
+	if template.Body.AtlasDocFormat.Value != "" {
+		doc, err := types.ParseDocument(template.Body.AtlasDocFormat.Value)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("failed to parse template: %w", err)
+		}
+		missing := doc.AtlassianDocumentExpandTemplate(vars)
+		body, err := doc.AtlassianDocumentToJSON()
+		if err != nil {
+			return "", "", nil, err
+		}
+		return body, "atlas_doc_format", missing, nil
+	}
+
+	// Older templates are only available in storage format
+	var missing []string
+	body := storagePlaceholderPattern.ReplaceAllStringFunc(template.Body.Storage.Value, func(match string) string {
+		text := html.UnescapeString(storagePlaceholderPattern.FindStringSubmatch(match)[1])
+		if value, ok := util.LookupVariable(vars, text); ok {
+			return html.EscapeString(value)
+		}
+		missing = append(missing, text)
+		return match
+	})
+	body, names := util.ExpandTemplateVariables(body, escapeValues(vars))
+	return body, "storage", append(missing, names...), nil
+}
+
+// escapeValues HTML-escapes variable values for insertion into storage format
+func escapeValues(vars map[string]string) map[string]string {
+	escaped := make(map[string]string, len(vars))
+	for key, value := range vars {
+		escaped[key] = html.EscapeString(value)
+	}
+	return escaped
+}
+
+// stripTitleHeading removes a leading "# Title" heading matching the page title,
+// as written by 'pages export', so the title is not repeated in the body
+func stripTitleHeading(body, title string, vars map[string]string) string {
+	expandedTitle, _ := util.ExpandTemplateVariables(title, vars)
+	trimmed := strings.TrimLeft(body, "\n")
+	firstLine, rest, _ := strings.Cut(trimmed, "\n")
+	if strings.HasPrefix(firstLine, "# ") && strings.TrimSpace(firstLine[2:]) == expandedTitle {
+		return strings.TrimLeft(rest, "\n")
+	}
+	return body
+}
+
+// uniqueStrings returns the values in order with duplicates removed
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+func init() {
+	pagesCmd.AddCommand(createCmd)
+	addCreateFlags(createCmd)
+	createCmd.Flags().String("parent", "", "Parent page ID, URL, or SPACE:Title")
+}
+
+// addCreateFlags registers the flags shared by the page and blog post create commands
+func addCreateFlags(cmd *cobra.Command) {
+	cmd.Flags().String("space", "", "Space key to create the content in")
+	cmd.Flags().String("title", "", "Title; may contain {{name}} variables")
+	cmd.Flags().StringP("file", "f", "", "Markdown file or local template for the body (- for stdin)")
+	cmd.Flags().String("template", "", "ID of a Confluence template to create the content from")
+	cmd.Flags().StringArray("var", nil, "Template variable as name=value (repeatable)")
+	cmd.Flags().StringSlice("labels", nil, "Labels to add")
+	cmd.Flags().String("status", "current", "Status: current or draft")
+	cmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
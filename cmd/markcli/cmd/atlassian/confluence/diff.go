@@ -0,0 +1,157 @@
+package confluence
+
+import (
+	"fmt"
+	"os"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	"markcli/internal/diff"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+
+	"github.com/spf13/cobra"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the differences between versions of a Confluence page",
+	Long: `Show the differences between two versions of a Confluence page, or between
+a version of the page and a local markdown file.
+
+Both sides are converted to markdown before comparing. By default the previous
+version is compared with the current one.
+
+Examples:
+  # Compare version 12 with version 15
+  markcli atlassian confluence pages diff --id 123456 --from 12 --to 15
+
+  # Show what the latest edit changed
+  markcli atlassian confluence pages diff --id 123456
+
+  # Word-level diff
+  markcli atlassian confluence pages diff --id 123456 --from 12 --to 15 --mode word
+
+  # Compare the current page with a local file
+  markcli atlassian confluence pages diff --id 123456 --file page.md`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("id")
+		if pageID == "" {
+			return fmt.Errorf("page ID is required")
+		}
+
+		fromVersion, _ := cmd.Flags().GetInt("from")
+		toVersion, _ := cmd.Flags().GetInt("to")
+		filePath, _ := cmd.Flags().GetString("file")
+		mode, _ := cmd.Flags().GetString("mode")
+		context, _ := cmd.Flags().GetInt("context")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if mode != "unified" && mode != "word" {
+			return fmt.Errorf("invalid mode %q: must be unified or word", mode)
+		}
+		if filePath != "" && cmd.Flags().Changed("to") {
+			return fmt.Errorf("--to cannot be combined with --file; use --from to pick the remote version")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		var fromName, toName, fromText, toText, title string
+		if filePath != "" {
+			// Remote page against a local file
+			remote, err := client.AtlassianConfluenceGetPageAtVersion(pageID, fromVersion)
+			if err != nil {
+				return fmt.Errorf("failed to get page: %w", err)
+			}
+			fromText, err = pageMarkdown(remote)
+			if err != nil {
+				return err
+			}
+
+			local, err := os.ReadFile(filePath)
+			if err != nil {
+				return fmt.Errorf("failed to read file: %w", err)
+			}
+			toText = string(local)
+
+			title = remote.Title
+			fromName = fmt.Sprintf("version %d", remote.Version.Number)
+			toName = filePath
+		} else {
+			// Default to the latest version and the one before it
+			to, err := client.AtlassianConfluenceGetPageAtVersion(pageID, toVersion)
+			if err != nil {
+				return fmt.Errorf("failed to get page: %w", err)
+			}
+			if fromVersion <= 0 {
+				fromVersion = to.Version.Number - 1
+			}
+			if fromVersion <= 0 {
+				return fmt.Errorf("page %s has no earlier version to compare with", pageID)
+			}
+			from, err := client.AtlassianConfluenceGetPageAtVersion(pageID, fromVersion)
+			if err != nil {
+				return fmt.Errorf("failed to get version %d: %w", fromVersion, err)
+			}
+
+			if fromText, err = pageMarkdown(from); err != nil {
+				return err
+			}
+			if toText, err = pageMarkdown(to); err != nil {
+				return err
+			}
+
+			title = to.Title
+			fromName = fmt.Sprintf("version %d", from.Version.Number)
+			toName = fmt.Sprintf("version %d", to.Version.Number)
+		}
+
+		// Compute the diff
+		var changes string
+		if mode == "word" {
+			changes = diff.Words(fromText, toText)
+		} else {
+			changes = diff.Unified(fromName, toName, fromText, toText, context)
+		}
+
+		// Format the diff
+		formatter := formatting.AtlassianConfluenceCreatePageDiffFormatter(title, fromName, toName, changes, mode == "word")
+		output := formatter.AtlassianConfluenceFormatPageDiffAsMarkdown()
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+// pageMarkdown converts the ADF body of a page to markdown
+func pageMarkdown(page *types.AtlassianConfluencePageDetails) (string, error) {
+	if page.Body.AtlasDocFormat.Value == "" {
+		return "", nil
+	}
+	md, err := types.AtlassianDocumentConvertJSONToMarkdown(page.Body.AtlasDocFormat.Value)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert version %d to markdown: %w", page.Version.Number, err)
+	}
+	return md, nil
+}
+
+func init() {
+	pagesCmd.AddCommand(diffCmd)
+	diffCmd.Flags().String("id", "", "Page ID to compare")
+	diffCmd.Flags().Int("from", 0, "Version to compare from (defaults to the version before --to)")
+	diffCmd.Flags().Int("to", 0, "Version to compare to (defaults to the current version)")
+	diffCmd.Flags().String("file", "", "Local markdown file to compare the page with")
+	diffCmd.Flags().String("mode", "unified", "Diff mode: unified or word")
+	diffCmd.Flags().Int("context", 3, "Number of context lines in unified mode")
+	diffCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	diffCmd.MarkFlagRequired("id")
+}
@@ -0,0 +1,128 @@
+package confluence
+
+import (
+	"fmt"
+	"os"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	types "markcli/internal/types/atlassian"
+
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a Confluence page as markdown with frontmatter",
+	Long: `Export a Confluence page as plain markdown preceded by YAML frontmatter
+with its ID, title, space, version, URL, labels and parent.
+
+The output is written to stdout unless --output is given.
+
+Examples:
+  markcli atlassian confluence pages export --id 123456
+  markcli atlassian confluence pages export --id 123456 --output runbook.md`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return exportContent(cmd, "page")
+	},
+}
+
+// exportContent exports a page or blog post as markdown with frontmatter
+func exportContent(cmd *cobra.Command, contentType string) error {
+	pageID, _ := cmd.Flags().GetString("id")
+	outputPath, _ := cmd.Flags().GetString("output")
+	siteName, _ := cmd.Flags().GetString("site")
+
+	// Get Atlassian configuration
+	cfg, err := config.GetAtlassianConfig(siteName)
+	if err != nil {
+		return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+	}
+
+	// Create client
+	client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+	// Get page or blog post
+	var page *types.AtlassianConfluencePageDetails
+	if contentType == "blogpost" {
+		page, err = client.AtlassianConfluenceGetBlogPost(pageID)
+	} else {
+		page, err = client.AtlassianConfluenceGetPage(pageID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", contentKind(contentType), err)
+	}
+
+	frontmatter, err := pageFrontmatter(client, cfg.BaseURL, page)
+	if err != nil {
+		return err
+	}
+
+	formatter := formatting.AtlassianConfluenceCreatePageExportFormatter(*page, frontmatter)
+	output, err := formatter.AtlassianConfluenceFormatPageAsExport()
+	if err != nil {
+		return err
+	}
+
+	// Export is plain markdown, so it is not rendered with Glamour
+	if outputPath == "" {
+		fmt.Print(output)
+		return nil
+	}
+	if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputPath, err)
+	}
+	fmt.Printf("Exported %s to %s\n", page.Title, outputPath)
+	return nil
+}
+
+// pageFrontmatter collects the metadata written to the frontmatter of an exported page or blog post
+func pageFrontmatter(client *atlassian.Client, baseURL string, page *types.AtlassianConfluencePageDetails) (types.AtlassianConfluencePageFrontmatter, error) {
+	frontmatter := types.AtlassianConfluencePageFrontmatter{
+		ID:       page.ID,
+		Title:    page.Title,
+		Version:  page.Version.Number,
+		Updated:  page.Version.CreatedAt,
+		ParentID: page.ParentId,
+		Type:     page.Type,
+	}
+	if page.Links.WebUI != "" {
+		frontmatter.URL = baseURL + "/wiki" + page.Links.WebUI
+	}
+
+	if page.SpaceId != "" {
+		space, err := client.AtlassianConfluenceGetSpaceByID(page.SpaceId)
+		if err != nil {
+			return frontmatter, fmt.Errorf("failed to get space: %w", err)
+		}
+		frontmatter.Space = space.Key
+	}
+
+	var labels []types.AtlassianConfluenceLabel
+	var err error
+	if page.Type == "blogpost" {
+		labels, err = client.AtlassianConfluenceGetBlogPostLabels(page.ID)
+	} else {
+		labels, err = client.AtlassianConfluenceGetPageLabels(page.ID)
+	}
+	if err != nil {
+		return frontmatter, fmt.Errorf("failed to get labels: %w", err)
+	}
+	frontmatter.Labels = types.AtlassianConfluenceLabelNames(labels)
+
+	return frontmatter, nil
+}
+
+func init() {
+	pagesCmd.AddCommand(exportCmd)
+	addExportFlags(exportCmd)
+}
+
+// addExportFlags registers the flags shared by the page and blog post export commands
+func addExportFlags(cmd *cobra.Command) {
+	cmd.Flags().String("id", "", "ID of the content to export")
+	cmd.Flags().StringP("output", "o", "", "File to write the export to (defaults to stdout)")
+	cmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	cmd.MarkFlagRequired("id")
+}
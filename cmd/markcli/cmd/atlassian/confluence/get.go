@@ -5,7 +5,6 @@ import (
 	"markcli/internal/api/atlassian"
 	"markcli/internal/config"
 	formatting "markcli/internal/formatting/atlassian"
-	"markcli/internal/logging"
 	"markcli/internal/rendering"
 
 	"github.com/spf13/cobra"
@@ -15,9 +14,14 @@ var getCmd = &cobra.Command{
 	Use:   "get",
 	Short: "Get a specific Confluence page by ID",
 	Long: `Get a specific Confluence page by ID using Confluence API v2.
+
+Besides a numeric ID, --id accepts a page URL, a tiny link (/x/AbCd),
+a display/SPACE/Title link, or SPACE:Title.
 	
-Example:
-  markcli atlassian confluence pages get --id 123456`,
+Examples:
+  markcli atlassian confluence pages get --id 123456
+  markcli atlassian confluence pages get --id https://mycompany.atlassian.net/wiki/x/AbCd
+  markcli atlassian confluence pages get --id "ENG:Release Process"`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		pageID, _ := cmd.Flags().GetString("id")
 		if pageID == "" {
@@ -26,43 +30,54 @@ Example:
 
 		siteName, _ := cmd.Flags().GetString("site")
 
-		// Get Atlassian configuration
-		cfg, err := config.GetAtlassianConfig(siteName)
-		if err != nil {
-			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
-		}
+		return PrintPage(siteName, pageID)
+	},
+}
 
-		// Create client
-		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+// PrintPage prints a page with its comments as markdown. pageRef may be a page ID,
+// a page URL, a tiny link or SPACE:Title.
+func PrintPage(siteName, pageRef string) error {
+	// Get Atlassian configuration
+	cfg, err := config.GetAtlassianConfig(siteName)
+	if err != nil {
+		return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+	}
 
-		// Get page
-		pageDetails, err := client.AtlassianConfluenceGetPage(pageID)
-		if err != nil {
-			return fmt.Errorf("failed to get page: %w", err)
-		}
+	// Create client
+	client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
 
-		// Get footer comments
-		comments, err := client.AtlassianConfluenceGetPageFooterComments(pageID)
-		if err != nil {
-			// Log the error but continue without comments
-			logging.LogDebug("Failed to get footer comments: %v", err)
-		} else {
-			pageDetails.Comments = comments
-		}
+	// Resolve URLs and titles to a page ID
+	pageID, err := client.AtlassianConfluenceResolvePageID(pageRef)
+	if err != nil {
+		return err
+	}
 
-		// Format the page details
-		formatter := formatting.AtlassianConfluenceCreatePageDetailsFormatter(*pageDetails)
-		output := formatter.AtlassianConfluenceFormatPageDetailsAsMarkdown()
+	// Get page
+	pageDetails, err := client.AtlassianConfluenceGetPage(pageID)
+	if err != nil {
+		return fmt.Errorf("failed to get page: %w", err)
+	}
 
-		// Print the formatted output using Glamour
-		rendering.PrintMarkdown(output)
-		return nil
-	},
+	// Get footer and inline comments with their replies
+	footer, inline, err := client.AtlassianConfluenceGetPageComments(pageID)
+	if err != nil {
+		return fmt.Errorf("failed to get comments: %w", err)
+	}
+	pageDetails.FooterComments = footer
+	pageDetails.InlineComments = inline
+
+	// Format the page details
+	formatter := formatting.AtlassianConfluenceCreatePageDetailsFormatter(*pageDetails)
+	output := formatter.AtlassianConfluenceFormatPageDetailsAsMarkdown()
+
+	// Print the formatted output using Glamour
+	rendering.PrintMarkdown(output)
+	return nil
 }
 
 func init() {
 	pagesCmd.AddCommand(getCmd)
-	getCmd.Flags().String("id", "", "Page ID to retrieve")
+	getCmd.Flags().String("id", "", "Page ID, URL, or SPACE:Title to retrieve")
 	getCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
 	getCmd.MarkFlagRequired("id")
 }
@@ -0,0 +1,76 @@
+package confluence
+
+import (
+	"fmt"
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List the version history of a Confluence page",
+	Long: `List the version history of a Confluence page with author, date and message.
+	
+Examples:
+  # Show the full history of a page
+  markcli atlassian confluence pages history --id 123456
+
+  # Show the 10 most recent versions
+  markcli atlassian confluence pages history --id 123456 --limit 10`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("id")
+		if pageID == "" {
+			return fmt.Errorf("page ID is required")
+		}
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		// Get page for its title
+		page, err := client.AtlassianConfluenceGetPage(pageID)
+		if err != nil {
+			return fmt.Errorf("failed to get page: %w", err)
+		}
+
+		// Get versions
+		versions, err := client.AtlassianConfluenceGetPageVersions(pageID, limit)
+		if err != nil {
+			return fmt.Errorf("failed to get page versions: %w", err)
+		}
+
+		// Resolve author names
+		for i := range versions {
+			versions[i].Author.AccountID = versions[i].AuthorID
+			versions[i].Author.DisplayName = client.AtlassianConfluenceUserDisplayName(versions[i].AuthorID)
+		}
+
+		// Format the history
+		formatter := formatting.AtlassianConfluenceCreatePageHistoryFormatter(page.Title, versions)
+		output := formatter.AtlassianConfluenceFormatPageHistoryAsMarkdown()
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+func init() {
+	pagesCmd.AddCommand(historyCmd)
+	historyCmd.Flags().String("id", "", "Page ID to show the history of")
+	historyCmd.Flags().IntP("limit", "l", 0, "Maximum number of versions to show (0 for all)")
+	historyCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	historyCmd.MarkFlagRequired("id")
+}
@@ -0,0 +1,241 @@
+package confluence
+
+import (
+	"fmt"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/logging"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var labelsCmd = &cobra.Command{
+	Use:   "labels",
+	Short: "Manage Confluence page labels",
+	Long: `List, add, and remove labels on Confluence pages, and find content by label.
+
+Available Commands:
+- list: List the labels on a page
+- add: Add labels to a page
+- remove: Remove labels from a page
+- search: Find content carrying a label
+
+Examples:
+  # List labels on a page
+  markcli atlassian confluence labels list --id 123456
+
+  # Tag a page
+  markcli atlassian confluence labels add --id 123456 runbook adr
+
+  # Remove a label
+  markcli atlassian confluence labels remove --id 123456 draft
+
+  # Find all runbooks in a space
+  markcli atlassian confluence labels search runbook --space OPS`,
+}
+
+var labelsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the labels on a Confluence page",
+	Long: `List the labels on a Confluence page.
+	
+Example:
+  markcli atlassian confluence labels list --id 123456`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("id")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		return printPageLabels(client, pageID)
+	},
+}
+
+var labelsAddCmd = &cobra.Command{
+	Use:   "add LABEL...",
+	Short: "Add labels to a Confluence page",
+	Long: `Add one or more labels to a Confluence page.
+	
+Example:
+  markcli atlassian confluence labels add --id 123456 runbook adr`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("id")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		if _, err := client.AtlassianConfluenceAddLabels(pageID, normalizeLabels(args)); err != nil {
+			return fmt.Errorf("failed to add labels: %w", err)
+		}
+
+		return printPageLabels(client, pageID)
+	},
+}
+
+var labelsRemoveCmd = &cobra.Command{
+	Use:   "remove LABEL...",
+	Short: "Remove labels from a Confluence page",
+	Long: `Remove one or more labels from a Confluence page.
+	
+Example:
+  markcli atlassian confluence labels remove --id 123456 draft`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageID, _ := cmd.Flags().GetString("id")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		for _, label := range normalizeLabels(args) {
+			if err := client.AtlassianConfluenceRemoveLabel(pageID, label); err != nil {
+				return fmt.Errorf("failed to remove label %s: %w", label, err)
+			}
+		}
+
+		return printPageLabels(client, pageID)
+	},
+}
+
+var labelsSearchCmd = &cobra.Command{
+	Use:   "search LABEL...",
+	Short: "Find Confluence content by label",
+	Long: `Find Confluence pages carrying all of the given labels.
+	
+Examples:
+  markcli atlassian confluence labels search runbook
+  markcli atlassian confluence labels search adr accepted --space ARCH`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		space, _ := cmd.Flags().GetString("space")
+		limit, _ := cmd.Flags().GetInt("limit")
+		page, _ := cmd.Flags().GetInt("page")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		// Calculate start position for pagination
+		startAt := (page - 1) * limit
+
+		labels := normalizeLabels(args)
+		results, err := client.AtlassianConfluenceSearchPages(types.AtlassianConfluenceSearchOptions{
+			SpaceKey:  space,
+			Labels:    labels,
+			StartAt:   startAt,
+			Limit:     limit,
+			SortBy:    "lastModified",
+			SortOrder: "desc",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to search by label: %w", err)
+		}
+
+		// Handle no results
+		if len(results.Results) == 0 {
+			logging.LogDebug("No content found with labels: %v", labels)
+			rendering.PrintMarkdown(fmt.Sprintf("No content found with label %s.", strings.Join(labels, ", ")))
+			return nil
+		}
+
+		// Format results
+		formatter := formatting.AtlassianConfluenceCreateSearchResultsFormatter(results.Results).WithLabels(true)
+		output := fmt.Sprintf("# Content labeled %s\n\n", strings.Join(labels, ", "))
+		output += formatter.AtlassianConfluenceFormatSearchResultsAsMarkdown()
+
+		// Add pagination info
+		output += fmt.Sprintf("\nShowing %d-%d of %d results\n",
+			startAt+1,
+			util.Min(startAt+len(results.Results), results.TotalSize),
+			results.TotalSize,
+		)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+// printPageLabels prints the current labels of a page
+func printPageLabels(client *atlassian.Client, pageID string) error {
+	page, err := client.AtlassianConfluenceGetPage(pageID)
+	if err != nil {
+		return fmt.Errorf("failed to get page: %w", err)
+	}
+
+	labels, err := client.AtlassianConfluenceGetPageLabels(pageID)
+	if err != nil {
+		return fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	formatter := formatting.AtlassianConfluenceCreateLabelsFormatter(page.Title, labels)
+	rendering.PrintMarkdown(formatter.AtlassianConfluenceFormatLabelsAsMarkdown())
+	return nil
+}
+
+// normalizeLabels lowercases labels and splits comma-separated values,
+// since Confluence stores labels in lowercase
+func normalizeLabels(args []string) []string {
+	var labels []string
+	for _, arg := range args {
+		for _, label := range strings.Split(arg, ",") {
+			label = strings.ToLower(strings.TrimSpace(label))
+			if label != "" {
+				labels = append(labels, label)
+			}
+		}
+	}
+	return labels
+}
+
+func init() {
+	Cmd.AddCommand(labelsCmd)
+	labelsCmd.AddCommand(labelsListCmd)
+	labelsCmd.AddCommand(labelsAddCmd)
+	labelsCmd.AddCommand(labelsRemoveCmd)
+	labelsCmd.AddCommand(labelsSearchCmd)
+
+	for _, c := range []*cobra.Command{labelsListCmd, labelsAddCmd, labelsRemoveCmd} {
+		c.Flags().String("id", "", "Page ID")
+		c.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+		c.MarkFlagRequired("id")
+	}
+
+	labelsSearchCmd.Flags().StringP("space", "s", "", "Space key to search in (e.g., TEAM)")
+	labelsSearchCmd.Flags().IntP("limit", "l", 100, "Number of results per page")
+	labelsSearchCmd.Flags().IntP("page", "p", 1, "Page number")
+	labelsSearchCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
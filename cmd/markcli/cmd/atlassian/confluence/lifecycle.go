@@ -0,0 +1,357 @@
+package confluence
+
+import (
+	"fmt"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var moveCmd = &cobra.Command{
+	Use:   "move",
+	Short: "Move a page and its descendants",
+	Long: `Move a page, together with its descendants, under a new parent page.
+With --space and no --parent, the page is moved under the homepage of that space.
+	
+Examples:
+  markcli atlassian confluence pages move --id 123456 --parent 654321
+  markcli atlassian confluence pages move --id 123456 --space ARCHIVE --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageRef, _ := cmd.Flags().GetString("id")
+		parentRef, _ := cmd.Flags().GetString("parent")
+		spaceKey, _ := cmd.Flags().GetString("space")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if parentRef == "" && spaceKey == "" {
+			return fmt.Errorf("either --parent or --space is required")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		tree, err := resolvePageTree(client, pageRef)
+		if err != nil {
+			return err
+		}
+
+		// Determine the new parent
+		var space *types.AtlassianConfluenceSpaceDetails
+		if spaceKey != "" {
+			space, err = client.AtlassianConfluenceGetSpaceByKey(spaceKey)
+			if err != nil {
+				return fmt.Errorf("failed to get space: %w", err)
+			}
+		}
+		parentID := ""
+		if parentRef != "" {
+			parentID, err = client.AtlassianConfluenceResolvePageID(parentRef)
+			if err != nil {
+				return err
+			}
+		} else {
+			if space.HomepageID == "" {
+				return fmt.Errorf("space %s has no homepage; use --parent", spaceKey)
+			}
+			parentID = space.HomepageID
+		}
+
+		parent, err := client.AtlassianConfluenceGetPage(parentID)
+		if err != nil {
+			return fmt.Errorf("failed to get parent page: %w", err)
+		}
+		if space != nil && parent.SpaceId != space.ID {
+			return fmt.Errorf("parent page %s is not in space %s", parentID, spaceKey)
+		}
+
+		// A page cannot be moved below itself
+		for _, id := range types.AtlassianConfluencePageTreeIDs(*tree) {
+			if id == parentID {
+				return fmt.Errorf("cannot move page %s under itself or one of its descendants", tree.ID)
+			}
+		}
+
+		summary := fmt.Sprintf("Move these pages under **%s** (ID: %s):", parent.Title, parent.ID)
+		proceed, err := confirmPageOperation(cmd, summary, *tree)
+		if err != nil || !proceed {
+			return err
+		}
+
+		if err := client.AtlassianConfluenceMovePage(tree.ID, parentID); err != nil {
+			return fmt.Errorf("failed to move page: %w", err)
+		}
+
+		fmt.Printf("Moved %s under %s\n", tree.Title, parent.Title)
+		return nil
+	},
+}
+
+var copyCmd = &cobra.Command{
+	Use:   "copy",
+	Short: "Copy a page, optionally with its descendants",
+	Long: `Copy a page under a parent page. Labels and attachments are preserved.
+With --recursive, all descendants are copied as well.
+
+Copies in the same space need a different title: use --title for a single
+page, or --title-prefix for a recursive copy.
+	
+Examples:
+  markcli atlassian confluence pages copy --id 123456 --to-parent 654321
+  markcli atlassian confluence pages copy --id 123456 --to-parent 654321 --recursive --title-prefix "2026 "`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageRef, _ := cmd.Flags().GetString("id")
+		parentRef, _ := cmd.Flags().GetString("to-parent")
+		recursive, _ := cmd.Flags().GetBool("recursive")
+		title, _ := cmd.Flags().GetString("title")
+		titlePrefix, _ := cmd.Flags().GetString("title-prefix")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if recursive && title != "" {
+			return fmt.Errorf("--title cannot be used with --recursive; use --title-prefix")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		tree, err := resolvePageTree(client, pageRef)
+		if err != nil {
+			return err
+		}
+		if !recursive {
+			tree.Children = nil
+		}
+
+		parentID, err := client.AtlassianConfluenceResolvePageID(parentRef)
+		if err != nil {
+			return err
+		}
+		parent, err := client.AtlassianConfluenceGetPage(parentID)
+		if err != nil {
+			return fmt.Errorf("failed to get parent page: %w", err)
+		}
+
+		summary := fmt.Sprintf("Copy these pages under **%s** (ID: %s):", parent.Title, parent.ID)
+		proceed, err := confirmPageOperation(cmd, summary, *tree)
+		if err != nil || !proceed {
+			return err
+		}
+
+		if title == "" && titlePrefix != "" {
+			title = titlePrefix + tree.Title
+		}
+		copyID, err := client.AtlassianConfluenceCopyPage(types.AtlassianConfluenceCopyPageOptions{
+			PageID:      tree.ID,
+			ParentID:    parentID,
+			Title:       title,
+			TitlePrefix: titlePrefix,
+			Recursive:   recursive,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to copy page: %w", err)
+		}
+
+		if copyID != "" {
+			fmt.Printf("Copied %s under %s (ID: %s)\n", tree.Title, parent.Title, copyID)
+		} else {
+			fmt.Printf("Copied %d pages under %s\n", len(types.AtlassianConfluencePageTreeIDs(*tree)), parent.Title)
+		}
+		return nil
+	},
+}
+
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Archive a page, optionally with its descendants",
+	Long: `Archive a page. With --recursive, its descendants are archived as well.
+	
+Examples:
+  markcli atlassian confluence pages archive --id 123456
+  markcli atlassian confluence pages archive --id 123456 --recursive --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageRef, _ := cmd.Flags().GetString("id")
+		recursive, _ := cmd.Flags().GetBool("recursive")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		tree, err := resolvePageTree(client, pageRef)
+		if err != nil {
+			return err
+		}
+		if !recursive {
+			tree.Children = nil
+		}
+
+		proceed, err := confirmPageOperation(cmd, "Archive these pages:", *tree)
+		if err != nil || !proceed {
+			return err
+		}
+
+		ids := types.AtlassianConfluencePageTreeIDs(*tree)
+		if err := client.AtlassianConfluenceArchivePages(ids); err != nil {
+			return fmt.Errorf("failed to archive pages: %w", err)
+		}
+
+		fmt.Printf("Archived %d pages\n", len(ids))
+		return nil
+	},
+}
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Move a page to the trash or purge it",
+	Long: `Delete a page by moving it to the trash, where it can be restored from.
+With --purge, the page is removed permanently.
+
+Child pages are moved up to the parent of the deleted page, unless
+--recursive is given, in which case they are deleted as well.
+	
+Examples:
+  markcli atlassian confluence pages delete --id 123456
+  markcli atlassian confluence pages delete --id 123456 --recursive --purge --yes`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageRef, _ := cmd.Flags().GetString("id")
+		recursive, _ := cmd.Flags().GetBool("recursive")
+		purge, _ := cmd.Flags().GetBool("purge")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		tree, err := resolvePageTree(client, pageRef)
+		if err != nil {
+			return err
+		}
+		if !recursive {
+			tree.Children = nil
+		}
+
+		action := "Move these pages to the trash:"
+		if purge {
+			action = "Permanently delete these pages:"
+		}
+		proceed, err := confirmPageOperation(cmd, action, *tree)
+		if err != nil || !proceed {
+			return err
+		}
+
+		// Delete descendants before their parents
+		ids := types.AtlassianConfluencePageTreeIDs(*tree)
+		var deleted []string
+		for i := len(ids) - 1; i >= 0; i-- {
+			if err := client.AtlassianConfluenceDeletePage(ids[i], purge); err != nil {
+				if len(deleted) > 0 {
+					fmt.Printf("Deleted %d pages before the failure: %s\n", len(deleted), strings.Join(deleted, ", "))
+				}
+				return fmt.Errorf("failed to delete page %s: %w", ids[i], err)
+			}
+			deleted = append(deleted, ids[i])
+		}
+
+		if purge {
+			fmt.Printf("Permanently deleted %d pages\n", len(ids))
+		} else {
+			fmt.Printf("Moved %d pages to the trash\n", len(ids))
+		}
+		return nil
+	},
+}
+
+// resolvePageTree resolves a page reference and fetches the page with its descendants
+func resolvePageTree(client *atlassian.Client, pageRef string) (*types.AtlassianConfluencePageNode, error) {
+	pageID, err := client.AtlassianConfluenceResolvePageID(pageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := client.AtlassianConfluenceGetPageTree(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page tree: %w", err)
+	}
+	return tree, nil
+}
+
+// confirmPageOperation prints the pages affected by an operation and asks for confirmation.
+// It returns false for dry runs, and an error when the user declines.
+func confirmPageOperation(cmd *cobra.Command, summary string, tree types.AtlassianConfluencePageNode) (bool, error) {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	formatter := formatting.AtlassianConfluenceCreatePageTreeFormatter(tree)
+	output := summary + "\n\n" + formatter.AtlassianConfluenceFormatPageTreeAsMarkdown()
+	if dryRun {
+		output += "\nDry run: no changes were made.\n"
+	}
+	rendering.PrintMarkdown(output)
+
+	if dryRun {
+		return false, nil
+	}
+	if yes {
+		return true, nil
+	}
+	if !util.Confirm("Proceed?") {
+		return false, fmt.Errorf("aborted")
+	}
+	return true, nil
+}
+
+func init() {
+	pagesCmd.AddCommand(moveCmd)
+	pagesCmd.AddCommand(copyCmd)
+	pagesCmd.AddCommand(archiveCmd)
+	pagesCmd.AddCommand(deleteCmd)
+
+	for _, c := range []*cobra.Command{moveCmd, copyCmd, archiveCmd, deleteCmd} {
+		c.Flags().String("id", "", "Page ID, URL, or SPACE:Title")
+		c.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+		c.Flags().Bool("dry-run", false, "Print the affected pages without changing anything")
+		c.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+		c.MarkFlagRequired("id")
+	}
+
+	moveCmd.Flags().String("parent", "", "New parent page ID or URL")
+	moveCmd.Flags().String("space", "", "Target space key; without --parent, moves under the space homepage")
+
+	copyCmd.Flags().String("to-parent", "", "Parent page ID or URL to copy under")
+	copyCmd.Flags().Bool("recursive", false, "Copy all descendants as well")
+	copyCmd.Flags().String("title", "", "Title of the copy (single page copies only)")
+	copyCmd.Flags().String("title-prefix", "", "Prefix added to the title of every copied page")
+	copyCmd.MarkFlagRequired("to-parent")
+
+	archiveCmd.Flags().Bool("recursive", false, "Archive all descendants as well")
+
+	deleteCmd.Flags().Bool("recursive", false, "Delete all descendants as well")
+	deleteCmd.Flags().Bool("purge", false, "Delete permanently instead of moving to the trash")
+}
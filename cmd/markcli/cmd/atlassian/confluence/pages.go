@@ -21,11 +21,21 @@ var pagesCmd = &cobra.Command{
 Available Commands:
 - search: Search for pages using text search
 - get: Get detailed information about a specific page
+- history: List the version history of a page
+- diff: Compare page versions or a page with a local file
+- export: Export a page as markdown with YAML frontmatter
+- create: Create a page from markdown or a template
+- move: Move a page and its descendants under a new parent
+- copy: Copy a page, optionally with its descendants
+- archive: Archive a page, optionally with its descendants
+- delete: Move a page to the trash or purge it
+- restrictions: Show and change who can view and edit a page
 
 Common Flags:
   --site: Specify which Atlassian site to use (optional)
   --debug: Enable debug mode for detailed logging
   --space: List all pages in a space (e.g., --space IN)
+  --labels: Show the labels of each page
 
 Examples:
   # Search for pages
@@ -35,10 +45,15 @@ Examples:
   # Get page content
   markcli atlassian confluence pages get --id 123456
 
+  # Review recent changes
+  markcli atlassian confluence pages history --id 123456
+  markcli atlassian confluence pages diff --id 123456 --from 12 --to 15
+
   # List all current pages in a space
   markcli atlassian confluence pages --space IN`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		spaceKey, _ := cmd.Flags().GetString("space")
+		showLabels, _ := cmd.Flags().GetBool("labels")
 		siteName, _ := cmd.Flags().GetString("site")
 
 		// If no space specified, show help
@@ -76,7 +91,7 @@ Examples:
 		}
 
 		// Format results
-		formatter := formatting.AtlassianConfluenceCreateSearchResultsFormatter(results.Results)
+		formatter := formatting.AtlassianConfluenceCreateSearchResultsFormatter(results.Results).WithLabels(showLabels)
 		output := fmt.Sprintf("# Pages in Space %s\n\n", spaceKey)
 		output += formatter.AtlassianConfluenceFormatSearchResultsAsMarkdown()
 
@@ -92,5 +107,6 @@ Examples:
 func init() {
 	Cmd.AddCommand(pagesCmd)
 	pagesCmd.Flags().String("space", "", "List all pages in a space (e.g., IN)")
+	pagesCmd.Flags().Bool("labels", false, "Show the labels of each page")
 	pagesCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
 }
@@ -0,0 +1,285 @@
+package confluence
+
+import (
+	"fmt"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+
+	"github.com/spf13/cobra"
+)
+
+var restrictionsCmd = &cobra.Command{
+	Use:   "restrictions",
+	Short: "Show who can view and edit a page",
+	Long: `Show the view and edit restrictions of a page, including view restrictions
+inherited from its ancestors. With --check, evaluate the effective access of a user.
+
+Available Commands:
+- add: Restrict viewing or editing to users or groups
+- remove: Remove users or groups from a restriction
+
+Examples:
+  # Show restrictions on a page and its ancestors
+  markcli atlassian confluence pages restrictions --id 123456
+
+  # Check whether a user can view and edit a page
+  markcli atlassian confluence pages restrictions --id 123456 --check user@example.com`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pageRef, _ := cmd.Flags().GetString("id")
+		checkUser, _ := cmd.Flags().GetString("check")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if pageRef == "" {
+			return fmt.Errorf("--id is required")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		pageID, err := client.AtlassianConfluenceResolvePageID(pageRef)
+		if err != nil {
+			return err
+		}
+
+		levels, err := pageRestrictionLevels(client, pageID)
+		if err != nil {
+			return err
+		}
+
+		var check *types.AtlassianConfluenceAccessCheck
+		if checkUser != "" {
+			check, err = checkPageAccess(client, pageID, checkUser, levels)
+			if err != nil {
+				return err
+			}
+		}
+
+		formatter := formatting.AtlassianConfluenceCreateRestrictionsFormatter(levels, check)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianConfluenceFormatRestrictionsAsMarkdown())
+		return nil
+	},
+}
+
+var restrictionsAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Restrict viewing or editing of a page to users or groups",
+	Long: `Add users or groups to the view or edit restriction of a page.
+Once a restriction has any users or groups, only they can perform the operation.
+	
+Examples:
+  markcli atlassian confluence pages restrictions add --id 123456 --operation edit --user me
+  markcli atlassian confluence pages restrictions add --id 123456 --operation view --group security-team`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeRestrictions(cmd, true)
+	},
+}
+
+var restrictionsRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove users or groups from a page restriction",
+	Long: `Remove users or groups from the view or edit restriction of a page.
+Removing the last user or group lifts the restriction.
+	
+Example:
+  markcli atlassian confluence pages restrictions remove --id 123456 --operation view --user user@example.com`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeRestrictions(cmd, false)
+	},
+}
+
+// pageRestrictionLevels returns the restrictions of a page followed by those of its
+// ancestors that restrict viewing, top-down
+func pageRestrictionLevels(client *atlassian.Client, pageID string) ([]types.AtlassianConfluencePageRestrictions, error) {
+	page, err := client.AtlassianConfluenceGetPage(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page: %w", err)
+	}
+
+	read, update, err := client.AtlassianConfluenceGetPageRestrictions(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page restrictions: %w", err)
+	}
+	levels := []types.AtlassianConfluencePageRestrictions{{
+		Page:   types.AtlassianConfluencePageNode{ID: page.ID, Title: page.Title, SpaceID: page.SpaceId},
+		Read:   read,
+		Update: update,
+	}}
+
+	ancestors, err := client.AtlassianConfluenceGetPageAncestors(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page ancestors: %w", err)
+	}
+	for _, ancestor := range ancestors {
+		read, _, err := client.AtlassianConfluenceGetPageRestrictions(ancestor.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get restrictions of page %s: %w", ancestor.ID, err)
+		}
+		// Only view restrictions are inherited
+		if read.Empty() {
+			continue
+		}
+
+		if ancestor.Title == "" {
+			if details, err := client.AtlassianConfluenceGetPage(ancestor.ID); err == nil {
+				ancestor.Title = details.Title
+			}
+		}
+		levels = append(levels, types.AtlassianConfluencePageRestrictions{
+			Page:      ancestor,
+			Inherited: true,
+			Read:      read,
+		})
+	}
+
+	return levels, nil
+}
+
+// checkPageAccess combines the permission check of Confluence with the restrictions
+// of each level to explain whether a user can view and edit a page
+func checkPageAccess(client *atlassian.Client, pageID, query string, levels []types.AtlassianConfluencePageRestrictions) (*types.AtlassianConfluenceAccessCheck, error) {
+	user, err := client.AtlassianConfluenceFindUser(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	groups, err := client.AtlassianConfluenceGetUserGroups(user.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups of %s: %w", user.DisplayName, err)
+	}
+
+	check := &types.AtlassianConfluenceAccessCheck{User: *user}
+	check.CanView, err = client.AtlassianConfluenceCheckPermission(pageID, user.AccountID, "read")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check view permission: %w", err)
+	}
+	check.CanEdit, err = client.AtlassianConfluenceCheckPermission(pageID, user.AccountID, "update")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check edit permission: %w", err)
+	}
+
+	blockedView, blockedEdit := false, false
+	for _, level := range levels {
+		if !level.Read.Allows(user.AccountID, groups) {
+			blockedView = true
+			where := "this page"
+			if level.Inherited {
+				where = fmt.Sprintf("ancestor %q (%s)", level.Page.Title, level.Page.ID)
+			}
+			check.Reasons = append(check.Reasons, fmt.Sprintf("Viewing is restricted on %s and the user is not allowed", where))
+		}
+	}
+	if !levels[0].Update.Allows(user.AccountID, groups) {
+		blockedEdit = true
+		check.Reasons = append(check.Reasons, "Editing is restricted on this page and the user is not allowed")
+	}
+	if !check.CanView && !blockedView {
+		check.Reasons = append(check.Reasons, "The user has no permission to view content in this space")
+	} else if check.CanView && !check.CanEdit && !blockedEdit && !blockedView {
+		check.Reasons = append(check.Reasons, "The user has no permission to edit content in this space")
+	}
+
+	return check, nil
+}
+
+// changeRestrictions adds or removes the users and groups given on the command line
+func changeRestrictions(cmd *cobra.Command, add bool) error {
+	pageRef, _ := cmd.Flags().GetString("id")
+	operation, _ := cmd.Flags().GetString("operation")
+	userRefs, _ := cmd.Flags().GetStringSlice("user")
+	groupNames, _ := cmd.Flags().GetStringSlice("group")
+	siteName, _ := cmd.Flags().GetString("site")
+
+	switch strings.ToLower(operation) {
+	case "view", "read":
+		operation = "read"
+	case "edit", "update":
+		operation = "update"
+	default:
+		return fmt.Errorf("invalid operation %q: must be view or edit", operation)
+	}
+	if len(userRefs) == 0 && len(groupNames) == 0 {
+		return fmt.Errorf("at least one --user or --group is required")
+	}
+
+	// Get Atlassian configuration
+	cfg, err := config.GetAtlassianConfig(siteName)
+	if err != nil {
+		return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+	}
+
+	// Create client
+	client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+	pageID, err := client.AtlassianConfluenceResolvePageID(pageRef)
+	if err != nil {
+		return err
+	}
+
+	change := client.AtlassianConfluenceRemoveRestriction
+	if add {
+		change = client.AtlassianConfluenceAddRestriction
+	}
+
+	for _, ref := range userRefs {
+		user, err := client.AtlassianConfluenceFindUser(ref)
+		if err != nil {
+			return fmt.Errorf("failed to find user %s: %w", ref, err)
+		}
+		if err := change(pageID, operation, "user", user.AccountID); err != nil {
+			return fmt.Errorf("failed to update restriction for %s: %w", user.DisplayName, err)
+		}
+	}
+	for _, name := range groupNames {
+		group, err := client.AtlassianConfluenceGetGroupByName(name)
+		if err != nil {
+			return fmt.Errorf("failed to find group %s: %w", name, err)
+		}
+		if err := change(pageID, operation, "group", group.ID); err != nil {
+			return fmt.Errorf("failed to update restriction for group %s: %w", name, err)
+		}
+	}
+
+	levels, err := pageRestrictionLevels(client, pageID)
+	if err != nil {
+		return err
+	}
+
+	formatter := formatting.AtlassianConfluenceCreateRestrictionsFormatter(levels, nil)
+
+	// Print the formatted output using Glamour
+	rendering.PrintMarkdown(formatter.AtlassianConfluenceFormatRestrictionsAsMarkdown())
+	return nil
+}
+
+func init() {
+	pagesCmd.AddCommand(restrictionsCmd)
+	restrictionsCmd.AddCommand(restrictionsAddCmd)
+	restrictionsCmd.AddCommand(restrictionsRemoveCmd)
+
+	restrictionsCmd.Flags().String("id", "", "Page ID or URL")
+	restrictionsCmd.Flags().String("check", "", "Email, name, account ID, or 'me' of a user whose effective access to check")
+	restrictionsCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	for _, c := range []*cobra.Command{restrictionsAddCmd, restrictionsRemoveCmd} {
+		c.Flags().String("id", "", "Page ID or URL")
+		c.Flags().String("operation", "", "Operation to restrict: view or edit")
+		c.Flags().StringSlice("user", nil, "Email, name, account ID, or 'me' of a user; repeatable")
+		c.Flags().StringSlice("group", nil, "Group name; repeatable")
+		c.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+		c.MarkFlagRequired("id")
+		c.MarkFlagRequired("operation")
+	}
+}
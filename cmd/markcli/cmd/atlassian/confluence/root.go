@@ -11,8 +11,15 @@ var Cmd = &cobra.Command{
 	Long: `Interact with Confluence to manage spaces, pages, and content.
 
 Available Commands:
-- spaces: List and filter Confluence spaces
+- spaces: List, inspect, create, and archive spaces
 - pages: Search, view, and manage pages
+- blogs: List, view, create, and export blog posts
+- content: List whiteboards, databases, folders, and embeds
+- comments: List, add, reply to, and resolve page comments
+- labels: Manage page labels and find content by label
+- attachments: List, download, and upload page attachments
+- templates: List page templates and blueprints
+- tasks: Find and complete inline tasks across pages
 - search: Search across all content
 
 Common Flags:
@@ -2,6 +2,7 @@ package confluence
 
 import (
 	"fmt"
+	"strings"
 
 	"markcli/internal/api/atlassian"
 	"markcli/internal/config"
@@ -16,37 +17,98 @@ import (
 var searchCmd = &cobra.Command{
 	Use:   "search",
 	Short: "Search Confluence pages",
-	Long: `Search for Confluence pages using text search.
+	Long: `Search for Confluence pages using text search and filters, or a raw CQL query.
 	
 Examples:
-  # Basic text search
+  # Search for pages containing text
   markcli atlassian confluence pages search -t "deployment process"
 
   # Search in a specific space
   markcli atlassian confluence pages search -t "deployment process" -s TEAM
 
+  # Search runbooks and show their labels
+  markcli atlassian confluence pages search -t "database" --label runbook --labels
+
+  # Pages below a parent page changed in the last week
+  markcli atlassian confluence pages search --ancestor 123456 --modified-since 7d
+
   # Search with pagination
   markcli atlassian confluence pages search -t "deployment process" --limit 20 --page 2`,
 	RunE: search,
 }
 
+var contentSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search across all Confluence content",
+	Long: `Search pages, blog posts, comments, and attachments using text search and filters,
+or a raw CQL query with --cql.
+
+Filter values are escaped, so quotes in search text cannot break the query.
+	
+Examples:
+  # Search pages and blog posts
+  markcli atlassian confluence search -t "incident review"
+
+  # Blog posts I contributed to this month
+  markcli atlassian confluence search --type blogpost --contributor me --modified-since 1M
+
+  # Comments mentioning a term
+  markcli atlassian confluence search --type comment -t "rollback"
+
+  # Raw CQL
+  markcli atlassian confluence search --cql 'type = page AND label = "adr" ORDER BY created DESC'`,
+	RunE: search,
+}
+
 func init() {
-	searchCmd.Flags().StringP("text", "t", "", "Search text")
-	searchCmd.Flags().StringP("space", "s", "", "Space key to search in (e.g., TEAM)")
-	searchCmd.Flags().IntP("limit", "l", 100, "Number of results per page")
-	searchCmd.Flags().IntP("page", "p", 1, "Page number")
-	searchCmd.Flags().StringP("site", "", "", "Atlassian site to use (defaults to the default site)")
-	searchCmd.MarkFlagRequired("text")
 	pagesCmd.AddCommand(searchCmd)
+	addSearchFlags(searchCmd, []string{"page"})
+
+	Cmd.AddCommand(contentSearchCmd)
+	addSearchFlags(contentSearchCmd, []string{"page", "blogpost"})
+}
+
+// addSearchFlags registers the text, filter, and pagination flags shared by the search commands
+func addSearchFlags(cmd *cobra.Command, defaultTypes []string) {
+	cmd.Flags().StringP("text", "t", "", "Search text")
+	cmd.Flags().StringP("space", "s", "", "Space key to search in (e.g., TEAM)")
+	cmd.Flags().String("cql", "", "Raw CQL query (cannot be combined with filter flags)")
+	cmd.Flags().StringSlice("type", defaultTypes, fmt.Sprintf("Content types to search (%s)", strings.Join(atlassian.AtlassianConfluenceContentTypes, "|")))
+	cmd.Flags().String("title", "", "Only include content whose title contains this text")
+	cmd.Flags().StringSlice("label", nil, "Only include content with these labels (repeatable)")
+	cmd.Flags().String("contributor", "", "Only include content edited by this account ID, or \"me\"")
+	cmd.Flags().String("modified-since", "", "Only include content modified since a date (YYYY-MM-DD) or duration (m=minutes, h, d, w, M=months, y; e.g. 7d, 3M)")
+	cmd.Flags().String("ancestor", "", "Only include content below this page ID")
+	cmd.Flags().Bool("labels", false, "Show the labels of each result")
+	cmd.Flags().IntP("limit", "l", 100, "Number of results per page")
+	cmd.Flags().IntP("page", "p", 1, "Page number")
+	cmd.Flags().StringP("site", "", "", "Atlassian site to use (defaults to the default site)")
 }
 
 func search(cmd *cobra.Command, args []string) error {
 	text, _ := cmd.Flags().GetString("text")
 	space, _ := cmd.Flags().GetString("space")
+	cql, _ := cmd.Flags().GetString("cql")
+	contentTypes, _ := cmd.Flags().GetStringSlice("type")
+	title, _ := cmd.Flags().GetString("title")
+	labels, _ := cmd.Flags().GetStringSlice("label")
+	contributor, _ := cmd.Flags().GetString("contributor")
+	modifiedSince, _ := cmd.Flags().GetString("modified-since")
+	ancestor, _ := cmd.Flags().GetString("ancestor")
+	showLabels, _ := cmd.Flags().GetBool("labels")
 	limit, _ := cmd.Flags().GetInt("limit")
 	page, _ := cmd.Flags().GetInt("page")
 	site, _ := cmd.Flags().GetString("site")
 
+	// A raw query replaces the generated one, so filters would be silently dropped
+	if cql != "" {
+		for _, name := range []string{"text", "space", "type", "title", "label", "contributor", "modified-since", "ancestor"} {
+			if cmd.Flags().Changed(name) {
+				return fmt.Errorf("--cql cannot be combined with --%s", name)
+			}
+		}
+	}
+
 	cfg, err := config.GetAtlassianConfig(site)
 	if err != nil {
 		return fmt.Errorf("failed to get Atlassian configuration: %w", err)
@@ -59,19 +121,26 @@ func search(cmd *cobra.Command, args []string) error {
 
 	// Perform search with pagination
 	searchOpts := types.AtlassianConfluenceSearchOptions{
-		Query:    text,
-		SpaceKey: space,
-		StartAt:  startAt,
-		Limit:    limit,
+		Query:         text,
+		SpaceKey:      space,
+		StartAt:       startAt,
+		Limit:         limit,
+		Labels:        normalizeLabels(labels),
+		CQL:           cql,
+		Types:         contentTypes,
+		Title:         title,
+		Contributor:   contributor,
+		ModifiedSince: modifiedSince,
+		Ancestor:      ancestor,
 	}
 
 	results, err := client.AtlassianConfluenceSearchPages(searchOpts)
 	if err != nil {
-		return fmt.Errorf("failed to search pages: %w", err)
+		return fmt.Errorf("failed to search content: %w", err)
 	}
 
 	// Format results
-	formatter := formatting.AtlassianConfluenceCreateSearchResultsFormatter(results.Results)
+	formatter := formatting.AtlassianConfluenceCreateSearchResultsFormatter(results.Results).WithLabels(showLabels)
 	output := formatter.AtlassianConfluenceFormatSearchResultsAsMarkdown()
 
 	// Add pagination info
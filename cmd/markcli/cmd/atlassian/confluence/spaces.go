@@ -5,22 +5,37 @@ import (
 	"markcli/internal/api/atlassian"
 	"markcli/internal/config"
 	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/logging"
 	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
 
 var spacesCmd = &cobra.Command{
 	Use:   "spaces",
-	Short: "List Confluence spaces",
-	Long: `List all accessible Confluence spaces.
+	Short: "List and manage Confluence spaces",
+	Long: `List all accessible Confluence spaces, or manage a single space.
+
+Available Commands:
+- get: Show the details of a space
+- permissions: Show the permission matrix of a space
+- create: Create a space
+- archive: Archive or restore a space
 	
 Examples:
   # List all spaces
   markcli atlassian confluence spaces
 
   # List all spaces including personal and archived
-  markcli atlassian confluence spaces --all`,
+  markcli atlassian confluence spaces --all
+
+  # Show a space and who can do what in it
+  markcli atlassian confluence spaces get TEAM
+  markcli atlassian confluence spaces permissions TEAM`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		siteName, _ := cmd.Flags().GetString("site")
 		includeAll, _ := cmd.Flags().GetBool("all")
@@ -56,8 +71,269 @@ Examples:
 	},
 }
 
+// maxSpaceLabelCounts caps how many content labels are counted by 'spaces get',
+// since each count is a separate search
+const maxSpaceLabelCounts = 25
+
+var spacesGetCmd = &cobra.Command{
+	Use:   "get KEY",
+	Short: "Show the details of a space",
+	Long: `Show the description, homepage, type, status, and owner of a space,
+together with its labels and how often each label is used on its content.
+	
+Example:
+  markcli atlassian confluence spaces get TEAM`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		space, err := client.AtlassianConfluenceGetSpaceByKey(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get space: %w", err)
+		}
+
+		summary := types.AtlassianConfluenceSpaceSummary{Space: *space}
+
+		if space.HomepageID != "" {
+			homepage, err := client.AtlassianConfluenceGetPage(space.HomepageID)
+			if err != nil {
+				logging.LogDebug("Failed to get homepage %s: %v", space.HomepageID, err)
+			} else {
+				summary.HomepageTitle = homepage.Title
+			}
+		}
+
+		ownerID := space.OwnerID
+		if ownerID == "" {
+			ownerID = space.AuthorID
+		}
+		summary.OwnerName = client.AtlassianConfluenceUserDisplayName(ownerID)
+
+		summary.Labels, err = client.AtlassianConfluenceGetSpaceLabels(space.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get space labels: %w", err)
+		}
+
+		contentLabels, err := client.AtlassianConfluenceGetSpaceContentLabels(space.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get content labels: %w", err)
+		}
+		if len(contentLabels) > maxSpaceLabelCounts {
+			logging.LogDebug("Counting only the first %d of %d content labels", maxSpaceLabelCounts, len(contentLabels))
+			contentLabels = contentLabels[:maxSpaceLabelCounts]
+		}
+		for _, label := range contentLabels {
+			cql := atlassian.AtlassianConfluenceNewCQLBuilder().
+				Where("space", "=", space.Key).
+				Where("label", "=", label.Name).
+				String()
+			results, err := client.AtlassianConfluenceSearchPages(types.AtlassianConfluenceSearchOptions{CQL: cql, Limit: 1})
+			if err != nil {
+				return fmt.Errorf("failed to count label %s: %w", label.Name, err)
+			}
+			summary.ContentLabels = append(summary.ContentLabels, types.AtlassianConfluenceLabelCount{
+				Name:  label.Name,
+				Count: results.TotalSize,
+			})
+		}
+		sort.SliceStable(summary.ContentLabels, func(i, j int) bool {
+			return summary.ContentLabels[i].Count > summary.ContentLabels[j].Count
+		})
+
+		formatter := formatting.AtlassianConfluenceCreateSpaceDetailsFormatter(cfg.BaseURL, summary)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianConfluenceFormatSpaceDetailsAsMarkdown())
+		return nil
+	},
+}
+
+var spacesPermissionsCmd = &cobra.Command{
+	Use:   "permissions KEY",
+	Short: "Show the permission matrix of a space",
+	Long: `Show which users, groups, and roles hold which operations in a space.
+	
+Example:
+  markcli atlassian confluence spaces permissions TEAM`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		space, err := client.AtlassianConfluenceGetSpaceByKey(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get space: %w", err)
+		}
+
+		permissions, err := client.AtlassianConfluenceGetSpacePermissions(space.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get space permissions: %w", err)
+		}
+
+		// Resolve principals to readable names
+		names := make(map[string]string)
+		for _, permission := range permissions {
+			id := permission.Principal.ID
+			if _, ok := names[id]; ok || id == "" {
+				continue
+			}
+			switch permission.Principal.Type {
+			case "user":
+				names[id] = client.AtlassianConfluenceUserDisplayName(id)
+			case "group":
+				group, err := client.AtlassianConfluenceGetGroup(id)
+				if err != nil {
+					logging.LogDebug("Failed to resolve group %s: %v", id, err)
+					names[id] = id
+				} else {
+					names[id] = group.Name
+				}
+			default:
+				names[id] = id
+			}
+		}
+
+		formatter := formatting.AtlassianConfluenceCreateSpacePermissionsFormatter(permissions, names)
+		output := fmt.Sprintf("# Permissions in Space %s\n\n", space.Key)
+		output += formatter.AtlassianConfluenceFormatSpacePermissionsAsMarkdown()
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+var spacesCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a space",
+	Long: `Create a Confluence space with a key, name, and optional description.
+	
+Examples:
+  markcli atlassian confluence spaces create --key ADR --name "Architecture Decisions"
+  markcli atlassian confluence spaces create --key SANDBOX --name "My Sandbox" --private`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key, _ := cmd.Flags().GetString("key")
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		private, _ := cmd.Flags().GetBool("private")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		space, err := client.AtlassianConfluenceCreateSpace(types.AtlassianConfluenceCreateSpaceOptions{
+			Key:         strings.ToUpper(key),
+			Name:        name,
+			Description: description,
+			Private:     private,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create space: %w", err)
+		}
+
+		formatter := formatting.AtlassianConfluenceCreateSpaceDetailsFormatter(cfg.BaseURL, types.AtlassianConfluenceSpaceSummary{Space: *space})
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown("Space created.\n\n" + formatter.AtlassianConfluenceFormatSpaceDetailsAsMarkdown())
+		return nil
+	},
+}
+
+var spacesArchiveCmd = &cobra.Command{
+	Use:   "archive KEY",
+	Short: "Archive or restore a space",
+	Long: `Archive a space, or restore an archived space with --restore.
+Archived spaces stay readable but are hidden from most lists and searches.
+	
+Examples:
+  markcli atlassian confluence spaces archive OLDPROJ
+  markcli atlassian confluence spaces archive OLDPROJ --restore --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		restore, _ := cmd.Flags().GetBool("restore")
+		yes, _ := cmd.Flags().GetBool("yes")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		space, err := client.AtlassianConfluenceGetSpaceByKey(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get space: %w", err)
+		}
+
+		status, action := "archived", "Archive"
+		if restore {
+			status, action = "current", "Restore"
+		}
+		if space.Status == status {
+			fmt.Printf("Space %s is already %s.\n", space.Key, status)
+			return nil
+		}
+
+		if !yes && !util.Confirm(fmt.Sprintf("%s space %s (%s)?", action, space.Key, space.Name)) {
+			return fmt.Errorf("aborted")
+		}
+
+		if err := client.AtlassianConfluenceSetSpaceStatus(space.Key, status); err != nil {
+			return fmt.Errorf("failed to %s space: %w", strings.ToLower(action), err)
+		}
+
+		fmt.Printf("Space %s is now %s.\n", space.Key, status)
+		return nil
+	},
+}
+
 func init() {
 	Cmd.AddCommand(spacesCmd)
 	spacesCmd.Flags().Bool("all", false, "Include personal and archived spaces")
 	spacesCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	spacesCmd.AddCommand(spacesGetCmd)
+	spacesCmd.AddCommand(spacesPermissionsCmd)
+	spacesCmd.AddCommand(spacesCreateCmd)
+	spacesCmd.AddCommand(spacesArchiveCmd)
+
+	spacesCreateCmd.Flags().String("key", "", "Key of the new space (e.g., ADR)")
+	spacesCreateCmd.Flags().String("name", "", "Name of the new space")
+	spacesCreateCmd.Flags().String("description", "", "Plain text description of the space")
+	spacesCreateCmd.Flags().Bool("private", false, "Create a space only visible to you")
+	spacesCreateCmd.MarkFlagRequired("key")
+	spacesCreateCmd.MarkFlagRequired("name")
+
+	spacesArchiveCmd.Flags().Bool("restore", false, "Restore an archived space instead")
+	spacesArchiveCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+
+	for _, c := range []*cobra.Command{spacesGetCmd, spacesPermissionsCmd, spacesCreateCmd, spacesArchiveCmd} {
+		c.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	}
 }
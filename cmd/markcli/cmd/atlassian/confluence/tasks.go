@@ -0,0 +1,250 @@
+package confluence
+
+import (
+	"fmt"
+	"time"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/logging"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+
+	"github.com/spf13/cobra"
+)
+
+var tasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "Find and complete inline tasks across pages",
+	Long: `Find inline tasks (action items) across Confluence pages and blog posts,
+and mark them as complete.
+
+Available Commands:
+- list: List tasks with their assignee, due date, and page
+- get: Show a single task
+- complete: Mark a task as complete, or reopen it
+
+Examples:
+  # My open action items
+  markcli atlassian confluence tasks list --assignee me
+
+  # Open tasks in a space that are overdue
+  markcli atlassian confluence tasks list --space TEAM --due-before 2026-10-18
+
+  # Show a task
+  markcli atlassian confluence tasks get 4242
+
+  # Complete a task
+  markcli atlassian confluence tasks complete 4242`,
+}
+
+var tasksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List inline tasks",
+	Long: `List inline tasks with their assignee, due date, and a link to the page they are on.
+Only incomplete tasks are listed unless --status says otherwise.
+	
+Examples:
+  markcli atlassian confluence tasks list --assignee me
+  markcli atlassian confluence tasks list --space TEAM --status complete --limit 20`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		assignee, _ := cmd.Flags().GetString("assignee")
+		spaceKey, _ := cmd.Flags().GetString("space")
+		dueBefore, _ := cmd.Flags().GetString("due-before")
+		status, _ := cmd.Flags().GetString("status")
+		limit, _ := cmd.Flags().GetInt("limit")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		opts := types.AtlassianConfluenceTaskOptions{Limit: limit}
+		switch status {
+		case "incomplete", "complete":
+			opts.Status = status
+		case "all":
+		default:
+			return fmt.Errorf("invalid status %q: must be incomplete, complete, or all", status)
+		}
+		if dueBefore != "" {
+			due, err := time.ParseInLocation("2006-01-02", dueBefore, time.Local)
+			if err != nil {
+				return fmt.Errorf("invalid due date %q: expected YYYY-MM-DD", dueBefore)
+			}
+			opts.DueBefore = due
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		if assignee != "" {
+			user, err := client.AtlassianConfluenceFindUser(assignee)
+			if err != nil {
+				return fmt.Errorf("failed to find assignee: %w", err)
+			}
+			opts.AssigneeID = user.AccountID
+		}
+		if spaceKey != "" {
+			space, err := client.AtlassianConfluenceGetSpaceByKey(spaceKey)
+			if err != nil {
+				return fmt.Errorf("failed to get space: %w", err)
+			}
+			opts.SpaceID = space.ID
+		}
+
+		tasks, err := client.AtlassianConfluenceListTasks(opts)
+		if err != nil {
+			return fmt.Errorf("failed to list tasks: %w", err)
+		}
+
+		formatter := formatting.AtlassianConfluenceCreateTasksFormatter(taskContents(client, cfg.BaseURL, tasks))
+		output := "# Tasks\n\n" + formatter.AtlassianConfluenceFormatTasksAsMarkdown()
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+var tasksGetCmd = &cobra.Command{
+	Use:   "get ID",
+	Short: "Show a single task",
+	Long: `Show an inline task with its status, assignee, due date, and page.
+Task IDs are shown by 'tasks list'.
+	
+Examples:
+  markcli atlassian confluence tasks get 4242`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		task, err := client.AtlassianConfluenceGetTask(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get task: %w", err)
+		}
+
+		formatter := formatting.AtlassianConfluenceCreateTasksFormatter(taskContents(client, cfg.BaseURL, []types.AtlassianConfluenceTask{*task}))
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianConfluenceFormatTasksAsMarkdown())
+		return nil
+	},
+}
+
+var tasksCompleteCmd = &cobra.Command{
+	Use:   "complete ID",
+	Short: "Mark a task as complete",
+	Long: `Mark an inline task as complete, or reopen it with --reopen.
+Task IDs are shown by 'tasks list'.
+	
+Examples:
+  markcli atlassian confluence tasks complete 4242
+  markcli atlassian confluence tasks complete 4242 --reopen`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reopen, _ := cmd.Flags().GetBool("reopen")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		status := "complete"
+		if reopen {
+			status = "incomplete"
+		}
+
+		task, err := client.AtlassianConfluenceSetTaskStatus(args[0], status)
+		if err != nil {
+			return fmt.Errorf("failed to update task: %w", err)
+		}
+
+		formatter := formatting.AtlassianConfluenceCreateTasksFormatter(taskContents(client, cfg.BaseURL, []types.AtlassianConfluenceTask{*task}))
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianConfluenceFormatTasksAsMarkdown())
+		return nil
+	},
+}
+
+// taskContents resolves the assignee and the page or blog post of each task.
+// Content is looked up once per page, and failures leave the title empty.
+func taskContents(client *atlassian.Client, baseURL string, tasks []types.AtlassianConfluenceTask) []types.AtlassianConfluenceTaskContent {
+	type content struct{ title, url string }
+	seen := make(map[string]content)
+
+	items := make([]types.AtlassianConfluenceTaskContent, 0, len(tasks))
+	for _, task := range tasks {
+		item := types.AtlassianConfluenceTaskContent{
+			Task:         task,
+			AssigneeName: client.AtlassianConfluenceUserDisplayName(task.AssignedTo),
+		}
+
+		id := task.PageID
+		if id == "" {
+			id = task.BlogPostID
+		}
+		if c, ok := seen[id]; ok {
+			item.ContentTitle, item.ContentURL = c.title, c.url
+		} else if id != "" {
+			var details *types.AtlassianConfluencePageDetails
+			var err error
+			if task.PageID != "" {
+				details, err = client.AtlassianConfluenceGetPage(task.PageID)
+			} else {
+				details, err = client.AtlassianConfluenceGetBlogPost(task.BlogPostID)
+			}
+			if err != nil {
+				logging.LogDebug("Failed to get content %s of task %s: %v", id, task.ID, err)
+				item.ContentTitle = id
+			} else {
+				item.ContentTitle = details.Title
+				if details.Links.WebUI != "" {
+					item.ContentURL = baseURL + "/wiki" + details.Links.WebUI
+				}
+			}
+			seen[id] = content{item.ContentTitle, item.ContentURL}
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func init() {
+	Cmd.AddCommand(tasksCmd)
+	tasksCmd.AddCommand(tasksListCmd)
+	tasksCmd.AddCommand(tasksGetCmd)
+	tasksCmd.AddCommand(tasksCompleteCmd)
+
+	tasksListCmd.Flags().String("assignee", "", "Assignee email, name, account ID, or 'me'")
+	tasksListCmd.Flags().StringP("space", "s", "", "Only list tasks in this space")
+	tasksListCmd.Flags().String("due-before", "", "Only list tasks due before this date (YYYY-MM-DD)")
+	tasksListCmd.Flags().String("status", "incomplete", "Task status: incomplete, complete, or all")
+	tasksListCmd.Flags().IntP("limit", "l", 100, "Maximum number of tasks to show (0 for all)")
+	tasksListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	tasksGetCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	tasksCompleteCmd.Flags().Bool("reopen", false, "Mark the task as incomplete instead")
+	tasksCompleteCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
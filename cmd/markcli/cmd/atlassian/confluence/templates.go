@@ -0,0 +1,72 @@
+package confluence
+
+import (
+	"fmt"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+
+	"github.com/spf13/cobra"
+)
+
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Work with Confluence page templates",
+	Long: `List Confluence page templates and blueprints.
+
+Use a template ID with 'pages create --template' to create a page from it.
+
+Available Commands:
+- list: List global or space templates
+
+Examples:
+  # List global templates
+  markcli atlassian confluence templates list
+
+  # List the templates of a space
+  markcli atlassian confluence templates list --space ARCH`,
+}
+
+var templatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List page templates and blueprints",
+	Long: `List page templates and blueprints, either global ones or those of a space.
+	
+Example:
+  markcli atlassian confluence templates list --space ARCH`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spaceKey, _ := cmd.Flags().GetString("space")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		templates, err := client.AtlassianConfluenceListTemplates(spaceKey)
+		if err != nil {
+			return fmt.Errorf("failed to list templates: %w", err)
+		}
+
+		heading := "# Global Templates\n\n"
+		if spaceKey != "" {
+			heading = fmt.Sprintf("# Templates in Space %s\n\n", spaceKey)
+		}
+		formatter := formatting.AtlassianConfluenceCreateTemplatesFormatter(templates)
+		rendering.PrintMarkdown(heading + formatter.AtlassianConfluenceFormatTemplatesAsMarkdown())
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(templatesCmd)
+	templatesCmd.AddCommand(templatesListCmd)
+	templatesListCmd.Flags().String("space", "", "Space key to list templates from (defaults to global templates)")
+	templatesListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
@@ -0,0 +1,266 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var attachmentsCmd = &cobra.Command{
+	Use:   "attachments",
+	Short: "Manage issue attachments",
+	Long: `List, download, and upload files attached to Jira issues.
+
+Screenshots embedded in an issue description are attachments too: "issues get" links
+them to the attachment, and "issues export" downloads them next to the exported issue.
+
+Available Commands:
+- list: List the attachments of an issue
+- download: Download attachments to a local directory
+- upload: Attach files to an issue
+
+Examples:
+  # List attachments
+  markcli atlassian jira attachments list PROJ-123
+
+  # Download a single file
+  markcli atlassian jira attachments download PROJ-123 screenshot.png
+
+  # Download everything into a directory
+  markcli atlassian jira attachments download PROJ-123 --all --dir out/
+
+  # Attach logs and screenshots
+  markcli atlassian jira attachments upload PROJ-123 crash.log screenshots/*.png`,
+}
+
+var attachmentsListCmd = &cobra.Command{
+	Use:   "list KEY",
+	Short: "List the attachments of an issue",
+	Long: `List the attachments of an issue with their type, size, and author.
+
+Example:
+  markcli atlassian jira attachments list PROJ-123`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		attachments, err := client.AtlassianJiraGetAttachments(issueKey)
+		if err != nil {
+			return fmt.Errorf("failed to get attachments: %w", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateAttachmentsFormatter(issueKey, attachments)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatAttachmentsAsMarkdown())
+		return nil
+	},
+}
+
+var attachmentsDownloadCmd = &cobra.Command{
+	Use:   "download KEY [NAME...]",
+	Short: "Download attachments of an issue",
+	Long: `Download attachments of an issue by file name or ID, or all of them with --all.
+Files are streamed to disk, with a progress indicator when running in a terminal.
+When several attachments share a name, the older ones are saved with their ID appended.
+
+Examples:
+  markcli atlassian jira attachments download PROJ-123 screenshot.png
+  markcli atlassian jira attachments download PROJ-123 --all --dir out/`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+		dir, _ := cmd.Flags().GetString("dir")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		names := args[1:]
+		if len(names) == 0 && !all {
+			return fmt.Errorf("specify attachment names or use --all")
+		}
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		attachments, err := client.AtlassianJiraGetAttachments(issueKey)
+		if err != nil {
+			return fmt.Errorf("failed to get attachments: %w", err)
+		}
+
+		selected := attachments
+		if !all {
+			selected = nil
+			for _, name := range names {
+				matched := false
+				for _, attachment := range attachments {
+					if attachment.Filename == name || attachment.ID == name {
+						selected = append(selected, attachment)
+						matched = true
+					}
+				}
+				if !matched {
+					return fmt.Errorf("issue %s has no attachment named %s", issueKey, name)
+				}
+			}
+		}
+
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", dir, err)
+		}
+
+		for _, attachment := range selected {
+			path := filepath.Join(dir, attachmentFileName(attachment, selected))
+			size, err := downloadJiraAttachment(client, attachment, path)
+			if err != nil {
+				return fmt.Errorf("failed to download %s: %w", attachment.Filename, err)
+			}
+			fmt.Printf("Downloaded %s (%s)\n", path, util.FormatBytes(size))
+		}
+		return nil
+	},
+}
+
+var attachmentsUploadCmd = &cobra.Command{
+	Use:   "upload KEY FILE...",
+	Short: "Attach files to an issue",
+	Long: `Attach files to an issue. Jira keeps files with the same name side by side, so
+uploading a file again adds another attachment rather than replacing it.
+
+Example:
+  markcli atlassian jira attachments upload PROJ-123 crash.log screenshot.png`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		for _, path := range args[1:] {
+			attachment, err := uploadJiraAttachment(client, issueKey, path)
+			if err != nil {
+				return fmt.Errorf("failed to upload %s: %w", path, err)
+			}
+			fmt.Printf("Uploaded %s to %s (%s, ID: %s)\n", attachment.Filename, issueKey, util.FormatBytes(attachment.Size), attachment.ID)
+		}
+		return nil
+	},
+}
+
+// attachmentFileName returns the local file name of an attachment. Jira allows several
+// attachments with the same name, so all but the newest get their ID appended.
+func attachmentFileName(attachment types.AtlassianJiraAttachment, attachments []types.AtlassianJiraAttachment) string {
+	name := filepath.Base(attachment.Filename)
+	for _, other := range attachments {
+		if other.Filename == attachment.Filename && other.Created > attachment.Created {
+			ext := filepath.Ext(name)
+			return fmt.Sprintf("%s-%s%s", name[:len(name)-len(ext)], attachment.ID, ext)
+		}
+	}
+	return name
+}
+
+// downloadJiraAttachment streams an attachment to path and returns the number of bytes written.
+// A partially written file is removed when the download fails.
+func downloadJiraAttachment(client *atlassian.Client, attachment types.AtlassianJiraAttachment, path string) (int64, error) {
+	content, size, err := client.AtlassianJiraDownloadAttachment(attachment)
+	if err != nil {
+		return 0, err
+	}
+	defer content.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+
+	progress := rendering.NewProgress("Downloading "+attachment.Filename, size)
+	written, err := io.Copy(io.MultiWriter(file, progress), content)
+	progress.Done()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return 0, err
+	}
+	return written, nil
+}
+
+// uploadJiraAttachment attaches a local file to an issue, reporting progress as it is sent
+func uploadJiraAttachment(client *atlassian.Client, issueKey, path string) (*types.AtlassianJiraAttachment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	name := filepath.Base(path)
+	progress := rendering.NewProgress("Uploading "+name, info.Size())
+	attachment, err := client.AtlassianJiraUploadAttachment(issueKey, name, io.TeeReader(file, progress))
+	progress.Done()
+	return attachment, err
+}
+
+func init() {
+	Cmd.AddCommand(attachmentsCmd)
+	attachmentsCmd.AddCommand(attachmentsListCmd)
+	attachmentsCmd.AddCommand(attachmentsDownloadCmd)
+	attachmentsCmd.AddCommand(attachmentsUploadCmd)
+
+	for _, c := range []*cobra.Command{attachmentsListCmd, attachmentsDownloadCmd, attachmentsUploadCmd} {
+		c.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	}
+
+	attachmentsDownloadCmd.Flags().Bool("all", false, "Download all attachments of the issue")
+	attachmentsDownloadCmd.Flags().String("dir", ".", "Directory to save the files to")
+}
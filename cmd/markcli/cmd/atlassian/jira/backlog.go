@@ -0,0 +1,69 @@
+package jira
+
+import (
+	"fmt"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+
+	"github.com/spf13/cobra"
+)
+
+var backlogCmd = &cobra.Command{
+	Use:   "backlog",
+	Short: "Show the backlog of a board",
+	Long: `Show the backlog of a board in rank order, with story points and their total.
+
+Story points are read from the estimation field of the board, or from the "Story Points"
+field of the site; use --points-field to choose another field.
+
+Examples:
+  markcli atlassian jira backlog --board 42
+  markcli atlassian jira backlog --board 42 --limit 20`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		boardID, _ := cmd.Flags().GetInt("board")
+		limit, _ := cmd.Flags().GetInt("limit")
+		pointsName, _ := cmd.Flags().GetString("points-field")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if boardID <= 0 {
+			return fmt.Errorf("--board is required; use 'jira boards list' to find board IDs")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		pointsField, err := pointsFieldID(client, pointsName, boardID)
+		if err != nil {
+			return err
+		}
+
+		issues, err := client.AtlassianJiraGetBacklogIssues(boardID, limit, pointsField)
+		if err != nil {
+			return fmt.Errorf("failed to get backlog: %w", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateBacklogFormatter(issues).WithPointsField(pointsField)
+		output := fmt.Sprintf("# Backlog of board %d\n\n%s", boardID, formatter.AtlassianJiraFormatBacklogAsMarkdown())
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(backlogCmd)
+	backlogCmd.Flags().Int("board", 0, "Board ID (required)")
+	backlogCmd.Flags().IntP("limit", "l", 100, "Maximum number of issues to show; 0 shows the whole backlog")
+	backlogCmd.Flags().String("points-field", "", "Field holding story points, by name or ID")
+	backlogCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
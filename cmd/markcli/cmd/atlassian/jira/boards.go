@@ -0,0 +1,126 @@
+package jira
+
+import (
+	"fmt"
+	"strconv"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+
+	"github.com/spf13/cobra"
+)
+
+var boardsCmd = &cobra.Command{
+	Use:   "boards",
+	Short: "List agile boards",
+	Long: `List the Scrum and Kanban boards of the site. Board IDs are used by the sprints
+and backlog commands.
+
+Available Commands:
+- list: List boards, optionally of one project
+
+Examples:
+  markcli atlassian jira boards list
+  markcli atlassian jira boards list --project SHOP`,
+}
+
+var boardsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List agile boards",
+	Long: `List agile boards with their ID, type, and project.
+
+Examples:
+  markcli atlassian jira boards list
+  markcli atlassian jira boards list --project SHOP
+  markcli atlassian jira boards list --name "Team Rocket"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectKey, _ := cmd.Flags().GetString("project")
+		name, _ := cmd.Flags().GetString("name")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		boards, err := client.AtlassianJiraListBoards(projectKey, name)
+		if err != nil {
+			return fmt.Errorf("failed to list boards: %w", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateBoardsFormatter(boards)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatBoardsAsMarkdown())
+		return nil
+	},
+}
+
+// parseAgileID parses a board or sprint ID
+func parseAgileID(kind, value string) (int, error) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid %s ID %q: expected a number", kind, value)
+	}
+	return id, nil
+}
+
+// storyPointsField returns the ID of the field holding story points: the estimation field
+// of the board when it is a custom field, otherwise the "Story Points" or "Story point
+// estimate" field of the site. It returns "" when none is found.
+func storyPointsField(client *atlassian.Client, boardID int) string {
+	if boardID > 0 {
+		if boardConfig, err := client.AtlassianJiraGetBoardConfiguration(boardID); err == nil {
+			if estimation := boardConfig.Estimation; estimation != nil && estimation.Type == "field" && estimation.Field.FieldID != "" {
+				// Time estimates are also estimation fields, but are not points
+				if _, ok := jiraTimeTrackingFields[estimation.Field.FieldID]; !ok {
+					return estimation.Field.FieldID
+				}
+			}
+		}
+	}
+
+	catalog, err := client.AtlassianJiraGetFieldCatalog()
+	if err != nil {
+		return ""
+	}
+	for _, name := range []string{"Story Points", "Story point estimate"} {
+		if field, ok := atlassian.AtlassianJiraFindField(catalog, name); ok {
+			return field.ID
+		}
+	}
+	return ""
+}
+
+// jiraTimeTrackingFields are estimation fields measured in seconds
+var jiraTimeTrackingFields = map[string]struct{}{
+	"timeoriginalestimate": {},
+	"timeestimate":         {},
+}
+
+// pointsFieldID resolves the --points-field flag, or finds the story points field of a board.
+// An empty ID means points are not shown.
+func pointsFieldID(client *atlassian.Client, name string, boardID int) (string, error) {
+	if name == "" {
+		return storyPointsField(client, boardID), nil
+	}
+	fields, err := client.AtlassianJiraResolveFields([]string{name})
+	if err != nil {
+		return "", err
+	}
+	return fields[0].ID, nil
+}
+
+func init() {
+	Cmd.AddCommand(boardsCmd)
+	boardsCmd.AddCommand(boardsListCmd)
+	boardsListCmd.Flags().StringP("project", "r", "", "Only list boards of this project (e.g., SHOP)")
+	boardsListCmd.Flags().String("name", "", "Only list boards whose name contains this text")
+	boardsListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
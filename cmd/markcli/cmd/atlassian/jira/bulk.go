@@ -0,0 +1,704 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultBulkConcurrency is the number of issues changed at the same time
+const defaultBulkConcurrency = 4
+
+var bulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Short: "Change every issue matching a JQL query",
+	Long: `Edit, transition, or comment on every issue matching a JQL query.
+
+Changes are planned for all issues first and shown as a table with --dry-run. Otherwise
+they are applied by a pool of workers after confirmation, and the outcome is reported per
+issue. When Jira answers with a rate limit, every worker waits as long as Jira asks.
+
+Each run writes a journal with the previous values of the changed issues, which
+"bulk undo" replays to revert the run. The journal is created before the first change
+and saved after every issue, so an interrupted run can be undone too.
+
+Available Commands:
+- edit: Set, add, or remove field values
+- transition: Move issues to another status
+- comment: Add a comment to every issue
+- undo: Revert a bulk run from its journal
+
+Examples:
+  # Preview a relabel, then apply it
+  markcli atlassian jira bulk edit --jql "project = SHOP AND labels = triage" --add labels=backend --remove labels=triage --dry-run
+  markcli atlassian jira bulk edit --jql "project = SHOP AND labels = triage" --add labels=backend --remove labels=triage
+
+  # Reassign and re-prioritize
+  markcli atlassian jira bulk edit --jql "assignee = jane@example.com AND resolution IS EMPTY" --set assignee=me --set priority=High
+
+  # Close resolved bugs
+  markcli atlassian jira bulk transition --jql "project = SHOP AND status = 'In Review'" --to Done --resolution Done
+
+  # Comment on every issue of a release
+  markcli atlassian jira bulk comment --jql 'fixVersion = "2.4"' --text "Released in 2.4"
+
+  # Revert a run
+  markcli atlassian jira bulk undo jira-bulk-edit-20261018-142501.json`,
+}
+
+var bulkEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit fields of every matching issue",
+	Long: `Edit the fields of every issue matching a JQL query. Fields and values work as with
+"issues edit": --set replaces a value, and --add and --remove change list fields.
+
+The journal holds the values of the edited fields before the change. Fields whose values
+cannot be set back as they are read, such as some custom field types, are left out of the
+journal; --dry-run warns about them.
+
+Examples:
+  markcli atlassian jira bulk edit --jql "project = SHOP AND sprint in openSprints()" --add labels=q4 --dry-run
+  markcli atlassian jira bulk edit --jql "assignee = jane@example.com AND resolution IS EMPTY" --set assignee=me
+  markcli atlassian jira bulk edit --jql "project = SHOP AND priority = Low" --set priority=Medium --journal reprioritize.json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		opts, err := bulkFlags(cmd, "edit")
+		if err != nil {
+			return err
+		}
+		changes, err := parseFieldChanges(cmd)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return fmt.Errorf("nothing to change: use --set, --add, or --remove")
+		}
+
+		described := make([]string, 0, len(changes))
+		for _, change := range changes {
+			value := change.Value
+			if strings.Contains(value, "\n") {
+				value = "(multi-line text)"
+			}
+			described = append(described, fmt.Sprintf("%s %s=%s", change.Operation, change.Field, value))
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		// Issues of the same project and type share an edit screen, so the update is
+		// built once for each of them. The lock only guards the map, so groups are
+		// fetched in parallel while issues of the same group wait for its first fetch.
+		type plannedEdit struct {
+			once   sync.Once
+			update map[string][]map[string]interface{}
+			fields map[string]types.AtlassianJiraFieldMeta // Edited fields, by ID
+			err    error
+		}
+		var mu sync.Mutex
+		edits := make(map[string]*plannedEdit)
+
+		return runBulk(client, cfg.BaseURL, opts, "Edit", func(issue types.AtlassianJiraIssue, result *types.AtlassianJiraIssueResult) bulkChange {
+			result.Change = strings.Join(described, ", ")
+
+			group := issue.Fields.Project.Key + "/" + issue.Fields.IssueType.Name
+			mu.Lock()
+			edit, ok := edits[group]
+			if !ok {
+				edit = &plannedEdit{}
+				edits[group] = edit
+			}
+			mu.Unlock()
+
+			edit.once.Do(func() {
+				meta, err := client.AtlassianJiraGetEditFields(issue.Key)
+				if err != nil {
+					edit.err = fmt.Errorf("failed to get editable fields: %w", err)
+					return
+				}
+				edit.update, edit.err = client.AtlassianJiraBuildUpdate(meta, changes)
+				edit.fields = make(map[string]types.AtlassianJiraFieldMeta)
+				for _, field := range meta {
+					if _, ok := edit.update[field.ID()]; ok {
+						if field.Name == "" {
+							field.Name = field.ID()
+						}
+						edit.fields[field.ID()] = field
+					}
+				}
+			})
+			if edit.err != nil {
+				result.Status = "failed"
+				result.Message = edit.err.Error()
+				return nil
+			}
+
+			fieldIDs := make([]string, 0, len(edit.update))
+			for id := range edit.update {
+				fieldIDs = append(fieldIDs, id)
+			}
+			sort.Strings(fieldIDs)
+
+			// Values of some field types cannot be set back as they are read
+			var notRestorable []string
+			for _, id := range fieldIDs {
+				if !atlassian.AtlassianJiraCanRestoreField(edit.fields[id]) {
+					notRestorable = append(notRestorable, edit.fields[id].Name)
+				}
+			}
+			if len(notRestorable) > 0 {
+				result.Message = "undo not supported for " + strings.Join(notRestorable, ", ")
+			}
+
+			return func() (*types.AtlassianJiraBulkJournalEntry, error) {
+				// Read the values right before changing them, so undo restores what was replaced
+				previous, err := client.AtlassianJiraGetIssue(issue.Key, fieldIDs...)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read current values: %w", err)
+				}
+				entry := &types.AtlassianJiraBulkJournalEntry{
+					Key:    issue.Key,
+					Fields: make(map[string]json.RawMessage, len(fieldIDs)),
+				}
+				// Journal the values in the form they are set in, leaving out those that cannot be
+				var skipped []string
+				for _, id := range fieldIDs {
+					value, ok := previous.FieldValue(id)
+					if !ok {
+						value = json.RawMessage("null")
+					}
+					settable, ok := atlassian.AtlassianJiraSettableValue(edit.fields[id], value)
+					if !ok {
+						skipped = append(skipped, edit.fields[id].Name)
+						continue
+					}
+					entry.Fields[id] = settable
+				}
+				if len(skipped) > 0 {
+					result.Message = "undo not supported for " + strings.Join(skipped, ", ")
+				}
+
+				if err := client.AtlassianJiraEditIssue(issue.Key, edit.update); err != nil {
+					return nil, err
+				}
+				return entry, nil
+			}
+		})
+	},
+}
+
+var bulkTransitionCmd = &cobra.Command{
+	Use:   "transition",
+	Short: "Transition every matching issue",
+	Long: `Move every issue matching a JQL query to another status. The transition is matched
+per issue as with "issues transition", and issues already in the target status are skipped.
+Required screen fields must be given with --field or --resolution.
+
+The journal holds the status of each issue before the transition; undo moves the issues
+back when their workflow allows it.
+
+Examples:
+  markcli atlassian jira bulk transition --jql "project = SHOP AND status = 'In Review'" --to Done --dry-run
+  markcli atlassian jira bulk transition --jql "sprint = 1234 AND status = 'To Do'" --to Backlog --yes
+  markcli atlassian jira bulk transition --jql "labels = wontfix" --to Closed --resolution "Won't Do" --comment-file why.md`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, _ := cmd.Flags().GetString("to")
+		resolution, _ := cmd.Flags().GetString("resolution")
+		commentFile, _ := cmd.Flags().GetString("comment-file")
+		fieldPairs, _ := cmd.Flags().GetStringArray("field")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		opts, err := bulkFlags(cmd, "transition")
+		if err != nil {
+			return err
+		}
+
+		values, err := util.ParseKeyValuePairs(fieldPairs)
+		if err != nil {
+			return err
+		}
+		if resolution != "" {
+			values["resolution"] = resolution
+		}
+
+		update, err := transitionCommentUpdate(commentFile)
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		return transitionIssues(client, cfg.BaseURL, opts, target, values, update)
+	},
+}
+
+var bulkCommentCmd = &cobra.Command{
+	Use:   "comment",
+	Short: "Comment on every matching issue",
+	Long: `Add the same comment to every issue matching a JQL query. The body is read from
+--text or --file (use "-" for stdin, together with --yes or --dry-run) and converted
+from markdown; visibility works as with "comments add".
+
+The journal holds the IDs of the added comments; undo deletes them.
+
+Examples:
+  markcli atlassian jira bulk comment --jql 'fixVersion = "2.4"' --text "Released in 2.4" --dry-run
+  markcli atlassian jira bulk comment --jql "project = HELP AND status = Waiting" --file reminder.md --public`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		opts, err := bulkFlags(cmd, "comment")
+		if err != nil {
+			return err
+		}
+		// The confirmation prompt reads stdin too, so it cannot be answered after the body
+		if filePath, _ := cmd.Flags().GetString("file"); filePath == "-" && !opts.dryRun && !opts.yes {
+			return fmt.Errorf("--yes is required when the comment body is read from stdin")
+		}
+		comment, err := commentOptions(cmd)
+		if err != nil {
+			return err
+		}
+		if comment.Body == nil {
+			return fmt.Errorf("comment body is empty: use --text or --file")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		change := "add comment"
+		switch {
+		case comment.Visibility != nil:
+			change += fmt.Sprintf(" visible to %s %s", comment.Visibility.Type, comment.Visibility.Value)
+		case comment.Internal != nil && *comment.Internal:
+			change += " (internal)"
+		}
+
+		return runBulk(client, cfg.BaseURL, opts, "Comment on", func(issue types.AtlassianJiraIssue, result *types.AtlassianJiraIssueResult) bulkChange {
+			result.Change = change
+			return func() (*types.AtlassianJiraBulkJournalEntry, error) {
+				added, err := client.AtlassianJiraAddComment(issue.Key, comment)
+				if err != nil {
+					return nil, err
+				}
+				return &types.AtlassianJiraBulkJournalEntry{Key: issue.Key, CommentID: added.ID}, nil
+			}
+		})
+	},
+}
+
+var bulkUndoCmd = &cobra.Command{
+	Use:   "undo JOURNAL",
+	Short: "Revert a bulk run from its journal",
+	Long: `Revert the changes recorded in the journal of a bulk run: edited fields get their
+previous values back, transitioned issues are moved back to their previous status, and
+added comments are deleted. Changes made to the issues since the run are overwritten.
+
+The journal must belong to the site the command runs against.
+
+Examples:
+  markcli atlassian jira bulk undo jira-bulk-edit-20261018-142501.json --dry-run
+  markcli atlassian jira bulk undo jira-bulk-transition-20261018-150312.json --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		yes, _ := cmd.Flags().GetBool("yes")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if concurrency < 1 {
+			return fmt.Errorf("--concurrency must be at least 1")
+		}
+
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to read journal: %w", err)
+		}
+		var journal types.AtlassianJiraBulkJournal
+		if err := json.Unmarshal(data, &journal); err != nil {
+			return fmt.Errorf("failed to read journal %s: %w", args[0], err)
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+		if strings.TrimSuffix(journal.Site, "/") != strings.TrimSuffix(cfg.BaseURL, "/") {
+			return fmt.Errorf("the journal was written for %s, not %s; use --site", journal.Site, cfg.BaseURL)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		results := make([]types.AtlassianJiraIssueResult, len(journal.Entries))
+		for i, entry := range journal.Entries {
+			results[i] = types.AtlassianJiraIssueResult{Key: entry.Key, Status: "dry run"}
+			switch journal.Operation {
+			case "edit":
+				fieldIDs := make([]string, 0, len(entry.Fields))
+				for id := range entry.Fields {
+					fieldIDs = append(fieldIDs, id)
+				}
+				sort.Strings(fieldIDs)
+				results[i].Change = "restore " + strings.Join(fieldIDs, ", ")
+			case "transition":
+				results[i].Change = "back to " + entry.Status
+			case "comment":
+				results[i].Change = "delete comment " + entry.CommentID
+			default:
+				return fmt.Errorf("unknown bulk operation %q in journal", journal.Operation)
+			}
+		}
+
+		if !dryRun && len(results) > 0 && !yes {
+			if !util.Confirm(fmt.Sprintf("Undo the %s of %d issues from %s?", journal.Operation, len(results), journal.Created)) {
+				return fmt.Errorf("aborted")
+			}
+		}
+
+		if !dryRun {
+			forEachIssue(len(journal.Entries), concurrency, func(i int) {
+				if err := undoBulkEntry(client, journal.Operation, journal.Entries[i]); err != nil {
+					results[i].Status = "failed"
+					results[i].Message = err.Error()
+					return
+				}
+				results[i].Status = "done"
+			})
+		}
+
+		formatter := formatting.AtlassianJiraCreateIssueResultsFormatter(results)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatIssueResultsAsMarkdown())
+		return nil
+	},
+}
+
+// bulkOptions holds the flags shared by the bulk commands
+type bulkOptions struct {
+	operation   string // "edit", "transition", or "comment", as recorded in the journal
+	jql         string
+	limit       int
+	concurrency int
+	dryRun      bool
+	yes         bool
+	journal     string // Path of the journal to write; empty writes none
+}
+
+// bulkChange applies the planned change to one issue and returns what is needed to undo it
+type bulkChange func() (*types.AtlassianJiraBulkJournalEntry, error)
+
+// bulkFlags reads the flags added by addBulkFlags. Without --journal, the journal is
+// written to a file in the current directory named after the operation and time.
+func bulkFlags(cmd *cobra.Command, operation string) (bulkOptions, error) {
+	opts := bulkOptions{operation: operation}
+	opts.jql, _ = cmd.Flags().GetString("jql")
+	opts.limit, _ = cmd.Flags().GetInt("limit")
+	opts.concurrency, _ = cmd.Flags().GetInt("concurrency")
+	opts.dryRun, _ = cmd.Flags().GetBool("dry-run")
+	opts.yes, _ = cmd.Flags().GetBool("yes")
+	opts.journal, _ = cmd.Flags().GetString("journal")
+
+	if opts.jql == "" {
+		return opts, fmt.Errorf("--jql is required")
+	}
+	if opts.limit < 1 {
+		return opts, fmt.Errorf("--limit must be at least 1")
+	}
+	if opts.concurrency < 1 {
+		return opts, fmt.Errorf("--concurrency must be at least 1")
+	}
+	if opts.journal == "" {
+		opts.journal = fmt.Sprintf("jira-bulk-%s-%s.json", operation, time.Now().Format("20060102-150405"))
+	}
+	return opts, nil
+}
+
+// runBulk plans a change for every issue matching the query, then applies the planned
+// changes from a pool of workers after confirmation, recording each one in the journal. plan fills in
+// the result of an issue and returns nil when the issue is skipped or cannot be changed.
+func runBulk(client *atlassian.Client, baseURL string, opts bulkOptions, verb string, plan func(issue types.AtlassianJiraIssue, result *types.AtlassianJiraIssueResult) bulkChange) error {
+	issues, truncated, err := collectIssues(client, opts.jql, opts.limit)
+	if err != nil {
+		return err
+	}
+	if truncated {
+		fmt.Fprintf(os.Stderr, "Warning: more than %d issues match the query; only the first %d are included. Raise --limit to include more.\n", opts.limit, opts.limit)
+	}
+
+	// Plan every issue before changing anything
+	results := make([]types.AtlassianJiraIssueResult, len(issues))
+	changes := make([]bulkChange, len(issues))
+	forEachIssue(len(issues), opts.concurrency, func(i int) {
+		results[i] = types.AtlassianJiraIssueResult{
+			Key:     issues[i].Key,
+			Summary: issues[i].Fields.Summary,
+			Status:  "dry run",
+		}
+		changes[i] = plan(issues[i], &results[i])
+	})
+
+	pending := 0
+	for _, change := range changes {
+		if change != nil {
+			pending++
+		}
+	}
+
+	if !opts.dryRun && pending > 0 && !opts.yes {
+		if !util.Confirm(fmt.Sprintf("%s %d of %d matching issues?", verb, pending, len(issues))) {
+			return fmt.Errorf("aborted")
+		}
+	}
+
+	if opts.dryRun {
+		formatter := formatting.AtlassianJiraCreateIssueResultsFormatter(results)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatIssueResultsAsMarkdown())
+		return nil
+	}
+
+	// The journal is created before the first change and saved after each one, so an
+	// interrupted run can still be undone. Without a journal path nothing is recorded,
+	// e.g. for "issues transition --jql".
+	var journal *bulkJournalWriter
+	if opts.journal != "" && pending > 0 {
+		journal, err = createBulkJournal(opts.journal, types.AtlassianJiraBulkJournal{
+			Operation: opts.operation,
+			Site:      baseURL,
+			JQL:       opts.jql,
+			Created:   time.Now().Format(time.RFC3339),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create journal, nothing was changed: %w", err)
+		}
+	}
+
+	forEachIssue(len(issues), opts.concurrency, func(i int) {
+		if changes[i] == nil {
+			return
+		}
+		// Stop changing issues once changes can no longer be recorded
+		if journal != nil && journal.Err() != nil {
+			results[i].Status = "skipped"
+			results[i].Message = "journal could not be written"
+			return
+		}
+		entry, err := changes[i]()
+		if err != nil {
+			results[i].Status = "failed"
+			results[i].Message = err.Error()
+			return
+		}
+		results[i].Status = "done"
+		if journal != nil && entry != nil {
+			if err := journal.Append(*entry); err != nil {
+				results[i].Message = "not recorded in the journal"
+			}
+		}
+	})
+
+	formatter := formatting.AtlassianJiraCreateIssueResultsFormatter(results)
+	output := formatter.AtlassianJiraFormatIssueResultsAsMarkdown()
+
+	var journalErr error
+	if journal != nil {
+		journalErr = journal.Err()
+		if journal.Len() > 0 {
+			output += fmt.Sprintf("\nJournal written to %s; revert with `markcli atlassian jira bulk undo %s`\n", opts.journal, opts.journal)
+		} else if journalErr == nil {
+			// Nothing was changed, so there is nothing to undo
+			os.Remove(opts.journal)
+		}
+	}
+
+	// Print the formatted output using Glamour
+	rendering.PrintMarkdown(output)
+	if journalErr != nil {
+		return fmt.Errorf("failed to write journal %s: %w", opts.journal, journalErr)
+	}
+	return nil
+}
+
+// forEachIssue calls fn for the indexes 0 to n-1 from a pool of workers and waits for all
+// of them. fn must only touch data of its own index.
+func forEachIssue(n, concurrency int, fn func(i int)) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < util.Min(concurrency, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// bulkJournalWriter saves the journal of a bulk run as entries are added. Workers
+// append concurrently, so all access goes through the mutex.
+type bulkJournalWriter struct {
+	mu      sync.Mutex
+	path    string
+	journal types.AtlassianJiraBulkJournal
+	err     error // First error writing the journal
+}
+
+// createBulkJournal writes an empty journal to path. An existing file is not overwritten,
+// since it may be the only way to undo an earlier run.
+func createBulkJournal(path string, journal types.AtlassianJiraBulkJournal) (*bulkJournalWriter, error) {
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("%s already exists", path)
+	}
+	w := &bulkJournalWriter{path: path, journal: journal}
+	if err := w.save(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Append adds the entry of a changed issue and saves the journal
+func (w *bulkJournalWriter) Append(entry types.AtlassianJiraBulkJournalEntry) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.journal.Entries = append(w.journal.Entries, entry)
+	if err := w.save(); err != nil {
+		if w.err == nil {
+			w.err = err
+		}
+		return err
+	}
+	return nil
+}
+
+// Err returns the first error writing the journal
+func (w *bulkJournalWriter) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// Len returns the number of entries in the journal
+func (w *bulkJournalWriter) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.journal.Entries)
+}
+
+// save writes the journal as indented JSON. It is written to a temporary file first and
+// renamed, so an interruption never leaves a truncated journal behind.
+func (w *bulkJournalWriter) save() error {
+	data, err := json.MarshalIndent(w.journal, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := w.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, w.path)
+}
+
+// undoBulkEntry reverts the change a bulk operation made to one issue
+func undoBulkEntry(client *atlassian.Client, operation string, entry types.AtlassianJiraBulkJournalEntry) error {
+	switch operation {
+	case "edit":
+		return client.AtlassianJiraSetIssueFields(entry.Key, entry.Fields)
+	case "comment":
+		return client.AtlassianJiraDeleteComment(entry.Key, entry.CommentID)
+	}
+
+	transitions, err := client.AtlassianJiraGetTransitions(entry.Key)
+	if err != nil {
+		return err
+	}
+	transition, err := atlassian.AtlassianJiraFindTransition(transitions, entry.Status)
+	if err != nil {
+		return err
+	}
+	fields, err := transitionFields(client, *transition, nil, false)
+	if err != nil {
+		return err
+	}
+	return client.AtlassianJiraTransitionIssue(entry.Key, transition.ID, fields, nil)
+}
+
+// addBulkFlags registers the query, worker, and journal flags shared by the bulk commands
+func addBulkFlags(cmd *cobra.Command) {
+	cmd.Flags().String("jql", "", "JQL query selecting the issues to change (required)")
+	cmd.Flags().Int("limit", 100, "Maximum number of issues to change (at least 1)")
+	cmd.Flags().Int("concurrency", defaultBulkConcurrency, "Number of issues changed at the same time")
+	cmd.Flags().Bool("dry-run", false, "Show what would be changed without changing anything")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().String("journal", "", "Path of the undo journal, which must not exist yet (default: jira-bulk-<operation>-<time>.json)")
+}
+
+func init() {
+	Cmd.AddCommand(bulkCmd)
+	bulkCmd.AddCommand(bulkEditCmd)
+	bulkCmd.AddCommand(bulkTransitionCmd)
+	bulkCmd.AddCommand(bulkCommentCmd)
+	bulkCmd.AddCommand(bulkUndoCmd)
+
+	addBulkFlags(bulkEditCmd)
+	addFieldChangeFlags(bulkEditCmd)
+	bulkEditCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	addBulkFlags(bulkTransitionCmd)
+	bulkTransitionCmd.Flags().String("to", "", "Target status or transition name (required)")
+	bulkTransitionCmd.Flags().String("resolution", "", "Resolution to set (e.g., Done, Won't Do)")
+	bulkTransitionCmd.Flags().String("comment-file", "", "Markdown file with a comment to add with the transition")
+	bulkTransitionCmd.Flags().StringArray("field", nil, "Screen field as name=value, by field name or ID; repeatable")
+	bulkTransitionCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	bulkTransitionCmd.MarkFlagRequired("to")
+
+	addBulkFlags(bulkCommentCmd)
+	addCommentFlags(bulkCommentCmd)
+
+	bulkUndoCmd.Flags().Int("concurrency", defaultBulkConcurrency, "Number of issues reverted at the same time")
+	bulkUndoCmd.Flags().Bool("dry-run", false, "Show what would be reverted without changing anything")
+	bulkUndoCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bulkUndoCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
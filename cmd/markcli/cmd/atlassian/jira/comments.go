@@ -0,0 +1,305 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var commentsCmd = &cobra.Command{
+	Use:   "comments",
+	Short: "View and manage issue comments",
+	Long: `List, add, edit, and delete comments on Jira issues.
+
+Comment bodies are written in markdown and converted to Atlassian Document Format.
+Comments can be restricted to a project role or group, and on Jira Service Management
+projects they can be marked internal or shared with the customer.
+
+Available Commands:
+- list: List all comments on an issue
+- add: Add a comment to an issue
+- edit: Replace the body of a comment
+- delete: Delete a comment
+
+Examples:
+  # List comments, newest first
+  markcli atlassian jira comments list PROJ-123 --order newest
+
+  # Add a comment from a file
+  markcli atlassian jira comments add PROJ-123 --file reply.md
+
+  # Add a comment only developers can see
+  markcli atlassian jira comments add PROJ-123 --text "Root cause is the cache" --role Developers
+
+  # Add an internal note on a service desk request
+  markcli atlassian jira comments add HELP-42 --text "Escalated to L2" --internal
+
+  # Edit or delete a comment by ID
+  markcli atlassian jira comments edit PROJ-123 10042 --file reply.md
+  markcli atlassian jira comments delete PROJ-123 10042`,
+}
+
+var commentsListCmd = &cobra.Command{
+	Use:   "list KEY",
+	Short: "List comments on an issue",
+	Long: `List all comments on an issue, with their IDs and visibility.
+
+Examples:
+  markcli atlassian jira comments list PROJ-123
+  markcli atlassian jira comments list PROJ-123 --order newest`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		order, _ := cmd.Flags().GetString("order")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		orderBy, err := commentsOrderBy("order", order)
+		if err != nil {
+			return err
+		}
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		comments, err := client.AtlassianJiraGetIssueComments(issueKey, orderBy)
+		if err != nil {
+			return fmt.Errorf("failed to get comments: %w", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateCommentsFormatter(issueKey, comments.Comments)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatCommentsAsMarkdown())
+		return nil
+	},
+}
+
+var commentsAddCmd = &cobra.Command{
+	Use:   "add KEY",
+	Short: "Add a comment to an issue",
+	Long: `Add a comment to an issue. The body is read from --text or --file (use "-" for stdin)
+and converted from markdown.
+
+Use --role or --group to restrict who can see the comment. On Jira Service Management
+projects, --internal keeps the comment from customers and --public shares it.
+
+Examples:
+  markcli atlassian jira comments add PROJ-123 --file reply.md
+  markcli atlassian jira comments add PROJ-123 --text "Deployed to staging" --group engineering
+  markcli atlassian jira comments add HELP-42 --file answer.md --public`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		opts, err := commentOptions(cmd)
+		if err != nil {
+			return err
+		}
+		if opts.Body == nil {
+			return fmt.Errorf("comment body is empty: use --text or --file")
+		}
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		comment, err := client.AtlassianJiraAddComment(issueKey, opts)
+		if err != nil {
+			return fmt.Errorf("failed to add comment: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Added comment %s to **%s**%s\n", comment.ID, issueKey, commentAudience(comment)))
+		return nil
+	},
+}
+
+var commentsEditCmd = &cobra.Command{
+	Use:   "edit KEY COMMENT_ID",
+	Short: "Edit a comment",
+	Long: `Replace the body of a comment with markdown from --text or --file (use "-" for stdin).
+Visibility and the internal flag are only changed when the corresponding flags are given.
+
+Examples:
+  markcli atlassian jira comments edit PROJ-123 10042 --file reply.md
+  markcli atlassian jira comments edit HELP-42 10050 --text "Escalated to L2" --internal`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		opts, err := commentOptions(cmd)
+		if err != nil {
+			return err
+		}
+		if opts.Body == nil {
+			return fmt.Errorf("comment body is empty: use --text or --file")
+		}
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		comment, err := client.AtlassianJiraUpdateComment(issueKey, args[1], opts)
+		if err != nil {
+			return fmt.Errorf("failed to edit comment: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Updated comment %s on **%s**%s\n", comment.ID, issueKey, commentAudience(comment)))
+		return nil
+	},
+}
+
+var commentsDeleteCmd = &cobra.Command{
+	Use:   "delete KEY COMMENT_ID",
+	Short: "Delete a comment",
+	Long: `Delete a comment from an issue. Asks for confirmation unless --yes is given.
+
+Example:
+  markcli atlassian jira comments delete PROJ-123 10042 --yes`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		yes, _ := cmd.Flags().GetBool("yes")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+		commentID := args[1]
+
+		if !yes && !util.Confirm(fmt.Sprintf("Delete comment %s on %s?", commentID, issueKey)) {
+			return fmt.Errorf("aborted")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		if err := client.AtlassianJiraDeleteComment(issueKey, commentID); err != nil {
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Deleted comment %s from **%s**\n", commentID, issueKey))
+		return nil
+	},
+}
+
+// commentOptions reads the body, visibility, and internal flags shared by add and edit.
+// Body is nil when no text was given.
+func commentOptions(cmd *cobra.Command) (types.AtlassianJiraCommentOptions, error) {
+	var opts types.AtlassianJiraCommentOptions
+
+	text, _ := cmd.Flags().GetString("text")
+	filePath, _ := cmd.Flags().GetString("file")
+	role, _ := cmd.Flags().GetString("role")
+	group, _ := cmd.Flags().GetString("group")
+	internal, _ := cmd.Flags().GetBool("internal")
+	public, _ := cmd.Flags().GetBool("public")
+
+	markdown, err := util.ReadTextInput(text, filePath)
+	if err != nil {
+		return opts, err
+	}
+	if strings.TrimSpace(markdown) != "" {
+		opts.Body = types.AtlassianDocumentConvertMarkdownToDocument(markdown)
+	}
+
+	switch {
+	case role != "" && group != "":
+		return opts, fmt.Errorf("use either --role or --group, not both")
+	case role != "":
+		opts.Visibility = &types.AtlassianJiraVisibility{Type: "role", Value: role}
+	case group != "":
+		opts.Visibility = &types.AtlassianJiraVisibility{Type: "group", Value: group}
+	}
+
+	switch {
+	case internal && public:
+		return opts, fmt.Errorf("use either --internal or --public, not both")
+	case internal, public:
+		opts.Internal = &internal
+	}
+
+	return opts, nil
+}
+
+// commentAudience describes who can see a comment, for confirmation messages
+func commentAudience(comment *types.AtlassianJiraComment) string {
+	switch {
+	case comment.Visibility != nil:
+		return fmt.Sprintf(", visible to %s %s", comment.Visibility.Type, comment.Visibility.Value)
+	case comment.Internal():
+		return ", internal"
+	}
+	return ""
+}
+
+// addCommentFlags registers the body and visibility flags of add and edit
+func addCommentFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("text", "t", "", "Comment body in markdown")
+	cmd.Flags().StringP("file", "f", "", "Markdown file with the comment body (\"-\" for stdin)")
+	cmd.Flags().String("role", "", "Restrict the comment to a project role (e.g., Developers)")
+	cmd.Flags().String("group", "", "Restrict the comment to a group")
+	cmd.Flags().Bool("internal", false, "Jira Service Management: hide the comment from customers")
+	cmd.Flags().Bool("public", false, "Jira Service Management: share the comment with customers")
+	cmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
+
+func init() {
+	Cmd.AddCommand(commentsCmd)
+	commentsCmd.AddCommand(commentsListCmd)
+	commentsCmd.AddCommand(commentsAddCmd)
+	commentsCmd.AddCommand(commentsEditCmd)
+	commentsCmd.AddCommand(commentsDeleteCmd)
+
+	commentsListCmd.Flags().String("order", "oldest", "Order of comments: oldest or newest first")
+	commentsListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	addCommentFlags(commentsAddCmd)
+	addCommentFlags(commentsEditCmd)
+
+	commentsDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	commentsDeleteCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
@@ -0,0 +1,265 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	"markcli/internal/markdown"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an issue from markdown",
+	Long: `Create a Jira issue. The description is read from a markdown file and converted
+to Atlassian Document Format.
+
+The file may start with YAML frontmatter holding any of the flags, plus a "fields"
+map for other fields by name or ID:
+
+  ---
+  project: SHOP
+  type: Story
+  summary: Checkout supports gift cards
+  labels: [payments]
+  fields:
+    Story Points: 5
+  ---
+
+Flags take precedence over frontmatter. Fields are validated against the create
+screen of the project and issue type, so missing required fields and invalid
+values are reported before anything is submitted.
+
+Examples:
+  markcli atlassian jira issues create --project SHOP --type Story --summary "Gift cards" --file desc.md
+  markcli atlassian jira issues create --file story.md --assignee me --labels payments,checkout
+  markcli atlassian jira issues create --project SHOP --type Sub-task --parent SHOP-12 --summary "Write tests"
+  markcli atlassian jira issues create --project SHOP --type Bug --summary "Crash" --field "Severity=Critical"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectKey, _ := cmd.Flags().GetString("project")
+		issueTypeName, _ := cmd.Flags().GetString("type")
+		summary, _ := cmd.Flags().GetString("summary")
+		filePath, _ := cmd.Flags().GetString("file")
+		assignee, _ := cmd.Flags().GetString("assignee")
+		labels, _ := cmd.Flags().GetStringSlice("labels")
+		priority, _ := cmd.Flags().GetString("priority")
+		parent, _ := cmd.Flags().GetString("parent")
+		components, _ := cmd.Flags().GetStringSlice("component")
+		fieldPairs, _ := cmd.Flags().GetStringArray("field")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		extraFields, err := util.ParseKeyValuePairs(fieldPairs)
+		if err != nil {
+			return err
+		}
+
+		// Read the description and frontmatter; flags take precedence
+		var description string
+		if filePath != "" {
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				return fmt.Errorf("failed to read file: %w", err)
+			}
+
+			var meta types.AtlassianJiraIssueFrontmatter
+			description, err = markdown.SplitFrontmatter(string(content), &meta)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", filePath, err)
+			}
+			projectKey = firstNonEmpty(projectKey, meta.Project)
+			issueTypeName = firstNonEmpty(issueTypeName, meta.Type)
+			summary = firstNonEmpty(summary, meta.Summary)
+			assignee = firstNonEmpty(assignee, meta.Assignee)
+			priority = firstNonEmpty(priority, meta.Priority)
+			parent = firstNonEmpty(parent, meta.Parent)
+			if len(labels) == 0 {
+				labels = meta.Labels
+			}
+			if len(components) == 0 {
+				components = meta.Components
+			}
+			for name, value := range meta.Fields {
+				if _, ok := extraFields[name]; !ok {
+					extraFields[name] = frontmatterValue(value)
+				}
+			}
+		}
+
+		switch {
+		case projectKey == "":
+			return fmt.Errorf("a project is required: use --project or 'project' in the frontmatter")
+		case issueTypeName == "":
+			return fmt.Errorf("an issue type is required: use --type or 'type' in the frontmatter")
+		case summary == "":
+			return fmt.Errorf("a summary is required: use --summary or 'summary' in the frontmatter")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		projectKey = strings.ToUpper(projectKey)
+		issueType, err := findIssueType(client, projectKey, issueTypeName)
+		if err != nil {
+			return err
+		}
+
+		meta, err := client.AtlassianJiraGetCreateFields(projectKey, issueType.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get create screen fields: %w", err)
+		}
+
+		fields := map[string]interface{}{
+			"project":   map[string]string{"key": projectKey},
+			"issuetype": map[string]string{"id": issueType.ID},
+			"summary":   summary,
+		}
+
+		// Collect every other value by field name, then shape it according to the field schema
+		values := make(map[string]string)
+		for name, value := range extraFields {
+			values[name] = value
+		}
+		if strings.TrimSpace(description) != "" {
+			values["description"] = description
+		}
+		if assignee != "" {
+			values["assignee"] = assignee
+		}
+		if len(labels) > 0 {
+			values["labels"] = strings.Join(labels, ",")
+		}
+		if priority != "" {
+			values["priority"] = priority
+		}
+		if parent != "" {
+			values["parent"] = parent
+		}
+		if len(components) > 0 {
+			values["components"] = strings.Join(components, ",")
+		}
+
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var problems []string
+		for _, name := range names {
+			field, ok := atlassian.AtlassianJiraFindFieldMeta(meta, name)
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s is not on the create screen of %s issues in %s", name, issueType.Name, projectKey))
+				continue
+			}
+			value, err := client.AtlassianJiraFieldValue(*field, values[name])
+			if err != nil {
+				problems = append(problems, err.Error())
+				continue
+			}
+			fields[field.ID()] = value
+		}
+
+		// Report required fields that are still missing
+		for _, field := range meta {
+			if !field.Required || field.HasDefaultValue {
+				continue
+			}
+			if _, ok := fields[field.ID()]; ok {
+				continue
+			}
+			problem := fmt.Sprintf("%s is required", field.Name)
+			if len(field.AllowedValues) > 0 {
+				allowed := make([]string, 0, len(field.AllowedValues))
+				for _, value := range field.AllowedValues {
+					allowed = append(allowed, value.Label())
+				}
+				problem += fmt.Sprintf(" (allowed values: %s)", strings.Join(allowed, ", "))
+			}
+			problems = append(problems, problem+"; use --field")
+		}
+
+		if len(problems) > 0 {
+			return fmt.Errorf("cannot create issue:\n  - %s", strings.Join(problems, "\n  - "))
+		}
+
+		created, err := client.AtlassianJiraCreateIssue(fields)
+		if err != nil {
+			return fmt.Errorf("failed to create issue: %w", err)
+		}
+
+		output := fmt.Sprintf("Created %s **%s**: %s\n\n%s/browse/%s\n", issueType.Name, created.Key, summary, cfg.BaseURL, created.Key)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+// findIssueType looks up an issue type of a project by ID or case-insensitive name
+func findIssueType(client *atlassian.Client, projectKey, name string) (*types.AtlassianJiraIssueType, error) {
+	issueTypes, err := client.AtlassianJiraGetCreateIssueTypes(projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue types of %s: %w", projectKey, err)
+	}
+
+	available := make([]string, 0, len(issueTypes))
+	for i, issueType := range issueTypes {
+		if issueType.ID == name || strings.EqualFold(issueType.Name, name) {
+			return &issueTypes[i], nil
+		}
+		available = append(available, issueType.Name)
+	}
+
+	return nil, fmt.Errorf("issue type %q does not exist in %s; available types: %s", name, projectKey, strings.Join(available, ", "))
+}
+
+// frontmatterValue turns a YAML value into the text form used by --field
+func frontmatterValue(value interface{}) string {
+	if list, ok := value.([]interface{}); ok {
+		items := make([]string, 0, len(list))
+		for _, item := range list {
+			items = append(items, fmt.Sprint(item))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(value)
+}
+
+// firstNonEmpty returns the first value that is not empty
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func init() {
+	issuesCmd.AddCommand(createCmd)
+	createCmd.Flags().String("project", "", "Project key (e.g., SHOP)")
+	createCmd.Flags().String("type", "", "Issue type name (e.g., Story, Bug, Sub-task)")
+	createCmd.Flags().String("summary", "", "Issue summary")
+	createCmd.Flags().StringP("file", "f", "", "Markdown file with the description and optional frontmatter")
+	createCmd.Flags().String("assignee", "", "Assignee email, name, account ID, or 'me'")
+	createCmd.Flags().StringSlice("labels", nil, "Labels to add (comma-separated)")
+	createCmd.Flags().String("priority", "", "Priority name (e.g., High)")
+	createCmd.Flags().String("parent", "", "Parent issue key, for sub-tasks and child issues")
+	createCmd.Flags().StringSlice("component", nil, "Component name; repeatable")
+	createCmd.Flags().StringArray("field", nil, "Other field as name=value, by field name or ID; repeatable")
+	createCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
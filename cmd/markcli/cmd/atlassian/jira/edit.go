@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+
+	"github.com/spf13/cobra"
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit KEY",
+	Short: "Edit issue fields by name",
+	Long: `Edit the fields of an issue. Fields are named as in the Jira UI (e.g. "Story Points")
+or by ID (e.g. customfield_10016), and values are converted to the shape each field
+expects: users by email or 'me', options and priorities by name, numbers, dates
+(YYYY-MM-DD), and markdown for rich text fields.
+
+- --set replaces a value; an empty value clears the field
+- --add and --remove change list fields such as labels, components, and multi-selects
+
+Values starting with @ are read from a file, e.g. --set description=@desc.md.
+	
+Examples:
+  markcli atlassian jira issues edit PROJ-123 --set summary="New title" --set "Story Points=5"
+  markcli atlassian jira issues edit PROJ-123 --add labels=backend --remove labels=triage
+  markcli atlassian jira issues edit PROJ-123 --set assignee=me --set priority=High
+  markcli atlassian jira issues edit PROJ-123 --set description=@desc.md`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		changes, err := parseFieldChanges(cmd)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return fmt.Errorf("nothing to change: use --set, --add, or --remove")
+		}
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		meta, err := client.AtlassianJiraGetEditFields(issueKey)
+		if err != nil {
+			return fmt.Errorf("failed to get editable fields: %w", err)
+		}
+
+		update, err := client.AtlassianJiraBuildUpdate(meta, changes)
+		if err != nil {
+			return err
+		}
+
+		if err := client.AtlassianJiraEditIssue(issueKey, update); err != nil {
+			return fmt.Errorf("failed to edit issue: %w", err)
+		}
+
+		var output strings.Builder
+		output.WriteString(fmt.Sprintf("Updated **%s**\n\n", issueKey))
+		for _, change := range changes {
+			value := change.Value
+			if strings.Contains(value, "\n") {
+				value = "(multi-line text)"
+			}
+			output.WriteString(fmt.Sprintf("- %s %s: %s\n", change.Operation, change.Field, value))
+		}
+		output.WriteString(fmt.Sprintf("\n%s/browse/%s\n", cfg.BaseURL, issueKey))
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output.String())
+		return nil
+	},
+}
+
+// parseFieldChanges reads the --set, --add, and --remove flags as field changes.
+// Values starting with @ are replaced by the contents of the named file.
+func parseFieldChanges(cmd *cobra.Command) ([]types.AtlassianJiraFieldChange, error) {
+	var changes []types.AtlassianJiraFieldChange
+	for _, operation := range []string{"set", "add", "remove"} {
+		pairs, _ := cmd.Flags().GetStringArray(operation)
+		for _, pair := range pairs {
+			field, value, ok := strings.Cut(pair, "=")
+			field = strings.TrimSpace(field)
+			if !ok || field == "" {
+				return nil, fmt.Errorf("invalid --%s value %q: expected field=value", operation, pair)
+			}
+			if strings.HasPrefix(value, "@") {
+				content, err := os.ReadFile(value[1:])
+				if err != nil {
+					return nil, fmt.Errorf("failed to read value of %s: %w", field, err)
+				}
+				value = string(content)
+			}
+			changes = append(changes, types.AtlassianJiraFieldChange{
+				Operation: operation,
+				Field:     field,
+				Value:     value,
+			})
+		}
+	}
+	return changes, nil
+}
+
+// addFieldChangeFlags adds the --set, --add, and --remove flags to a command
+func addFieldChangeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("set", nil, "Set a field as name=value; repeatable")
+	cmd.Flags().StringArray("add", nil, "Add to a list field as name=value; repeatable")
+	cmd.Flags().StringArray("remove", nil, "Remove from a list field as name=value; repeatable")
+}
+
+func init() {
+	issuesCmd.AddCommand(editCmd)
+	addFieldChangeFlags(editCmd)
+	editCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
@@ -0,0 +1,130 @@
+package jira
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/logging"
+
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export an issue as markdown with its attachments",
+	Long: `Export an issue as plain markdown, with its fields, description and comments.
+
+Attachments are downloaded into a directory next to the export, named after the
+issue (e.g. PROJ-123-attachments), and the attachment table and the screenshots
+embedded in the description link to these local copies, so the export can be read
+offline. Use --skip-attachments to link to Jira instead.
+
+The output is written to stdout unless --output is given; attachments are then
+saved in the current directory.
+
+Examples:
+  markcli atlassian jira issues export --id PROJ-123 --output PROJ-123.md
+  markcli atlassian jira issues export --id PROJ-123 --output notes/PROJ-123.md --fields "Story Points,Sprint"
+  markcli atlassian jira issues export --id PROJ-123 --skip-attachments`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		issueID, _ := cmd.Flags().GetString("id")
+		outputPath, _ := cmd.Flags().GetString("output")
+		skipAttachments, _ := cmd.Flags().GetBool("skip-attachments")
+		fieldNames, _ := cmd.Flags().GetStringSlice("fields")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		// Resolve URLs to an issue key
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(issueID)
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		// Resolve the extra fields to export
+		var defaultFields []string
+		if cfg.JiraFields != nil {
+			defaultFields = cfg.JiraFields.Issue
+		}
+		fields, fieldIDs, err := fieldSelection(client, fieldNames, defaultFields)
+		if err != nil {
+			return err
+		}
+
+		issue, err := client.AtlassianJiraGetIssue(issueKey, fieldIDs...)
+		if err != nil {
+			return fmt.Errorf("failed to get issue: %w", err)
+		}
+
+		comments, err := client.AtlassianJiraGetIssueComments(issueKey, "created")
+		if err != nil {
+			// Log the error but continue without comments
+			logging.LogDebug("Failed to get comments: %v", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateIssueDetailsFormatter(*issue).
+			WithFields(fields, !selectsAllFields(fieldIDs))
+		if comments != nil {
+			formatter.WithComments(comments)
+		}
+
+		// Download attachments next to the export and link to the local copies
+		if !skipAttachments && len(issue.Fields.Attachments) > 0 {
+			baseDir := "."
+			if outputPath != "" {
+				baseDir = filepath.Dir(outputPath)
+			}
+			dirName := issue.Key + "-attachments"
+			dir := filepath.Join(baseDir, dirName)
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return fmt.Errorf("failed to create directory %s: %w", dir, err)
+			}
+
+			links := make(map[string]string, len(issue.Fields.Attachments))
+			for _, attachment := range issue.Fields.Attachments {
+				name := attachmentFileName(attachment, issue.Fields.Attachments)
+				if _, err := downloadJiraAttachment(client, attachment, filepath.Join(dir, name)); err != nil {
+					return fmt.Errorf("failed to download %s: %w", attachment.Filename, err)
+				}
+				links[attachment.ID] = url.PathEscape(dirName) + "/" + url.PathEscape(name)
+			}
+			formatter.WithAttachmentLinks(links)
+			fmt.Fprintf(os.Stderr, "Downloaded %d attachments to %s\n", len(links), dir)
+		}
+
+		output := formatter.AtlassianJiraFormatIssueDetailsAsMarkdown()
+
+		// Export is plain markdown, so it is not rendered with Glamour
+		if outputPath == "" {
+			fmt.Print(output)
+			return nil
+		}
+		if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", outputPath, err)
+		}
+		fmt.Printf("Exported %s to %s\n", issue.Key, outputPath)
+		return nil
+	},
+}
+
+func init() {
+	issuesCmd.AddCommand(exportCmd)
+	exportCmd.Flags().String("id", "", "Issue key or URL to export (e.g., PROJ-123)")
+	exportCmd.Flags().StringP("output", "o", "", "File to write the markdown to (defaults to stdout)")
+	exportCmd.Flags().Bool("skip-attachments", false, "Link attachments to Jira instead of downloading them")
+	exportCmd.Flags().StringSlice("fields", nil, "Extra fields to export, by name or ID (comma-separated, \"*all\" for every field)")
+	exportCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	exportCmd.MarkFlagRequired("id")
+}
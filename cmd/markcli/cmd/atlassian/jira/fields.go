@@ -0,0 +1,45 @@
+package jira
+
+import (
+	"markcli/internal/api/atlassian"
+	types "markcli/internal/types/atlassian"
+)
+
+// fieldSelection resolves the fields chosen with a flag, falling back to the site's default
+// field set. It returns the fields to display and the field IDs to request.
+func fieldSelection(client *atlassian.Client, names, defaults []string) ([]types.AtlassianJiraField, []string, error) {
+	if len(names) == 0 {
+		names = defaults
+	}
+	if len(names) == 0 {
+		return nil, nil, nil
+	}
+
+	fields, err := client.AtlassianJiraResolveFields(names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if selectsAllFields(names) {
+		return fields, []string{"*all"}, nil
+	}
+
+	ids := make([]string, 0, len(fields))
+	for _, field := range fields {
+		// The key is part of every issue rather than a field
+		if field.ID != "issuekey" {
+			ids = append(ids, field.ID)
+		}
+	}
+	return fields, ids, nil
+}
+
+// selectsAllFields reports whether "*all" is among the chosen field names
+func selectsAllFields(names []string) bool {
+	for _, name := range names {
+		if name == "*all" {
+			return true
+		}
+	}
+	return false
+}
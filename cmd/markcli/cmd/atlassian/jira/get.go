@@ -17,9 +17,20 @@ var getCmd = &cobra.Command{
 	Use:   "get",
 	Short: "Get a specific Jira issue by ID",
 	Long: `Get a specific Jira issue by ID using Jira API v3.
+
+Besides an issue key, --id accepts an issue URL such as a browse or board link.
+All comments are shown, oldest first unless --comments-order newest is given.
+
+Use --fields to show other fields, including custom fields, by name or ID; "*all" shows
+every field with a value. Without --fields, the "jira_fields.issue" list of the site
+configuration is used.
 	
-Example:
-  markcli atlassian jira issues get --id PROJ-123`,
+Examples:
+  markcli atlassian jira issues get --id PROJ-123
+  markcli atlassian jira issues get --id PROJ-123 --comments-order newest
+  markcli atlassian jira issues get --id PROJ-123 --fields "Story Points,Sprint,Team"
+  markcli atlassian jira issues get --id PROJ-123 --fields "*all"
+  markcli atlassian jira issues get --id https://mycompany.atlassian.net/browse/PROJ-123`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		issueID, _ := cmd.Flags().GetString("id")
 		if issueID == "" {
@@ -27,60 +38,104 @@ Example:
 		}
 
 		siteName, _ := cmd.Flags().GetString("site")
+		commentsOrder, _ := cmd.Flags().GetString("comments-order")
+		fieldNames, _ := cmd.Flags().GetStringSlice("fields")
 
-		// Get Atlassian configuration
-		cfg, err := config.GetAtlassianConfig(siteName)
-		if err != nil {
-			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
-		}
+		return PrintIssue(siteName, issueID, commentsOrder, fieldNames)
+	},
+}
+
+// PrintIssue prints an issue with its comments as markdown. issueRef may be an issue
+// key or URL; without fieldNames, the "jira_fields.issue" list of the site is shown.
+func PrintIssue(siteName, issueRef, commentsOrder string, fieldNames []string) error {
+	orderBy, err := commentsOrderBy("comments-order", commentsOrder)
+	if err != nil {
+		return err
+	}
+
+	// Resolve URLs to an issue key
+	issueID, err := atlassian.AtlassianJiraResolveIssueKey(issueRef)
+	if err != nil {
+		return err
+	}
+
+	// Get Atlassian configuration
+	cfg, err := config.GetAtlassianConfig(siteName)
+	if err != nil {
+		return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+	}
 
-		// Create client
-		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
-
-		// Get issue
-		issue, err := client.AtlassianJiraGetIssue(issueID)
-		if err != nil {
-			// Check for specific API errors
-			if apiErr, ok := err.(*types.AtlassianJiraError); ok {
-				switch apiErr.StatusCode {
-				case http.StatusUnauthorized:
-					return fmt.Errorf("authentication failed: please check your API token and email")
-				case http.StatusForbidden:
-					return fmt.Errorf("access denied: you don't have permission to view this issue")
-				case http.StatusNotFound:
-					return fmt.Errorf("issue not found: %s", issueID)
-				default:
-					if apiErr.Message != "" {
-						return fmt.Errorf("Jira API error: %s", apiErr.Message)
-					}
+	// Create client
+	client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+	// Resolve the extra fields to show
+	var defaultFields []string
+	if cfg.JiraFields != nil {
+		defaultFields = cfg.JiraFields.Issue
+	}
+	fields, fieldIDs, err := fieldSelection(client, fieldNames, defaultFields)
+	if err != nil {
+		return err
+	}
+
+	// Get issue
+	issue, err := client.AtlassianJiraGetIssue(issueID, fieldIDs...)
+	if err != nil {
+		// Check for specific API errors
+		if apiErr, ok := err.(*types.AtlassianJiraError); ok {
+			switch apiErr.StatusCode {
+			case http.StatusUnauthorized:
+				return fmt.Errorf("authentication failed: please check your API token and email")
+			case http.StatusForbidden:
+				return fmt.Errorf("access denied: you don't have permission to view this issue")
+			case http.StatusNotFound:
+				return fmt.Errorf("issue not found: %s", issueID)
+			default:
+				if apiErr.Message != "" {
+					return fmt.Errorf("Jira API error: %s", apiErr.Message)
 				}
 			}
-			return fmt.Errorf("failed to get issue: %w", err)
 		}
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
 
-		// Get comments
-		comments, err := client.AtlassianJiraGetIssueComments(issueID)
-		if err != nil {
-			// Log the error but continue without comments
-			logging.LogDebug("Failed to get comments: %v", err)
-		}
+	// Get comments
+	comments, err := client.AtlassianJiraGetIssueComments(issueID, orderBy)
+	if err != nil {
+		// Log the error but continue without comments
+		logging.LogDebug("Failed to get comments: %v", err)
+	}
 
-		// Format the issue details
-		formatter := formatting.AtlassianJiraCreateIssueDetailsFormatter(*issue)
-		if comments != nil {
-			formatter.WithComments(comments)
-		}
-		output := formatter.AtlassianJiraFormatIssueDetailsAsMarkdown()
+	// Format the issue details
+	formatter := formatting.AtlassianJiraCreateIssueDetailsFormatter(*issue).
+		WithFields(fields, !selectsAllFields(fieldIDs))
+	if comments != nil {
+		formatter.WithComments(comments)
+	}
+	output := formatter.AtlassianJiraFormatIssueDetailsAsMarkdown()
 
-		// Print the formatted output using Glamour
-		rendering.PrintMarkdown(output)
-		return nil
-	},
+	// Print the formatted output using Glamour
+	rendering.PrintMarkdown(output)
+	return nil
+}
+
+// commentsOrderBy maps the value of the comment order flag to the orderBy parameter of the comments API.
+// flag is the name of the flag, used in the error message.
+func commentsOrderBy(flag, order string) (string, error) {
+	switch order {
+	case "", "oldest":
+		return "created", nil
+	case "newest":
+		return "-created", nil
+	}
+	return "", fmt.Errorf("invalid --%s %q: use oldest or newest", flag, order)
 }
 
 func init() {
 	issuesCmd.AddCommand(getCmd)
-	getCmd.Flags().String("id", "", "Issue ID to retrieve (e.g., PROJ-123)")
+	getCmd.Flags().String("id", "", "Issue key or URL to retrieve (e.g., PROJ-123)")
+	getCmd.Flags().StringSlice("fields", nil, "Extra fields to show, by name or ID (comma-separated, \"*all\" for every field)")
+	getCmd.Flags().String("comments-order", "oldest", "Order of comments: oldest or newest first")
 	getCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
 	getCmd.MarkFlagRequired("id")
 }
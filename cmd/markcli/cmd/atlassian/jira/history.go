@@ -0,0 +1,110 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history KEY",
+	Short: "Show who changed what on an issue",
+	Long: `Show the change history of an issue, oldest first: every field change with its old
+and new value, and a timeline of the statuses the issue went through with the time
+spent in each. Description changes are shown as a diff of their markdown.
+
+Use --field to only show changes to some fields, and --format json for structured
+output that can be kept for audits.
+
+Examples:
+  markcli atlassian jira issues history PROJ-123
+  markcli atlassian jira issues history PROJ-123 --field status
+  markcli atlassian jira issues history PROJ-123 --field assignee,priority
+  markcli atlassian jira issues history PROJ-123 --format json > PROJ-123-history.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fields, _ := cmd.Flags().GetStringSlice("field")
+		format, _ := cmd.Flags().GetString("format")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if format != "markdown" && format != "json" {
+			return fmt.Errorf("invalid format %q: must be markdown or json", format)
+		}
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		issue, err := client.AtlassianJiraGetIssue(issueKey)
+		if err != nil {
+			return fmt.Errorf("failed to get issue: %w", err)
+		}
+
+		changelogs, err := client.AtlassianJiraGetChangelog(issueKey)
+		if err != nil {
+			return fmt.Errorf("failed to get changelog: %w", err)
+		}
+
+		// The status timeline is left out when other fields are picked
+		var periods []types.AtlassianJiraStatusPeriod
+		if len(fields) == 0 || containsFold(fields, "status") {
+			periods = atlassian.AtlassianJiraStatusPeriods(issue, changelogs, time.Now())
+		}
+		changelogs = atlassian.AtlassianJiraFilterChangelog(changelogs, fields)
+
+		if format == "json" {
+			output, err := json.MarshalIndent(struct {
+				Issue         string                            `json:"issue"`
+				Changes       []types.AtlassianJiraChangelog    `json:"changes"`
+				StatusPeriods []types.AtlassianJiraStatusPeriod `json:"statusPeriods,omitempty"`
+			}{issue.Key, changelogs, periods}, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode history: %w", err)
+			}
+			fmt.Println(string(output))
+			return nil
+		}
+
+		formatter := formatting.AtlassianJiraCreateHistoryFormatter(issue.Key, changelogs).WithStatusPeriods(periods)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatHistoryAsMarkdown())
+		return nil
+	},
+}
+
+// containsFold reports whether values contains value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	issuesCmd.AddCommand(historyCmd)
+	historyCmd.Flags().StringSlice("field", nil, "Only show changes to these fields, by name or ID (comma-separated)")
+	historyCmd.Flags().String("format", "markdown", "Output format: markdown or json")
+	historyCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
@@ -18,8 +18,14 @@ var issuesCmd = &cobra.Command{
 	Long: `Manage Jira issues including search, view, and comments.
 
 Available Commands:
-- search: Search for issues using text search
+- search: Search for issues using text, filters, or JQL
 - get: Get detailed information about a specific issue
+- export: Export an issue as markdown with its attachments
+- create: Create an issue from markdown
+- edit: Edit issue fields by name
+- transition: Move issues through their workflow
+- tree: Show an issue with its child issues
+- history: Show who changed what on an issue
 
 Common Flags:
   --site: Specify which Atlassian site to use (optional)
@@ -81,7 +87,14 @@ Examples:
 		output += formatter.AtlassianJiraFormatSearchResultsAsMarkdown()
 
 		// Add result count
-		output += fmt.Sprintf("\nShowing %d of %d issues\n", len(results.Issues), results.Total)
+		total := -1
+		if !results.IsLast {
+			if total, err = client.AtlassianJiraCountIssues(jql); err != nil {
+				logging.LogDebug("Failed to count issues: %v", err)
+				total = -1
+			}
+		}
+		output += fmt.Sprintf("\nShowing %s issues\n", formatting.AtlassianJiraFormatSearchRange(0, len(results.Issues), results.IsLast, total))
 
 		// Print the formatted output using Glamour
 		rendering.PrintMarkdown(output)
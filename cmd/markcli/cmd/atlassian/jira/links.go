@@ -0,0 +1,164 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+
+	"github.com/spf13/cobra"
+)
+
+var linksCmd = &cobra.Command{
+	Use:   "links",
+	Short: "Link and unlink issues",
+	Long: `Create and remove links between Jira issues.
+
+Links are written as a sentence: the relation is any outward or inward description
+of a link type, such as "blocks", "is blocked by", "relates to", or "duplicates", or
+the name of a link type. Linked issues are shown by "jira issues get".
+
+Available Commands:
+- add: Link two issues
+- remove: Remove a link between two issues
+
+Examples:
+  markcli atlassian jira links add PROJ-1 blocks PROJ-2
+  markcli atlassian jira links add PROJ-3 is duplicated by PROJ-4
+  markcli atlassian jira links remove PROJ-1 blocks PROJ-2`,
+}
+
+var linksAddCmd = &cobra.Command{
+	Use:   "add KEY RELATION KEY",
+	Short: "Link two issues",
+	Long: `Link two issues, e.g. "PROJ-1 blocks PROJ-2". The relation may be several words
+and does not need quotes.
+
+Examples:
+  markcli atlassian jira links add PROJ-1 blocks PROJ-2
+  markcli atlassian jira links add PROJ-1 relates to PROJ-7`,
+	Args: cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		fromKey, relation, toKey, err := linkArgs(args)
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		linkType, source, target, err := findLinkRelation(client, fromKey, relation, toKey)
+		if err != nil {
+			return err
+		}
+
+		if err := client.AtlassianJiraLinkIssues(linkType.Name, source, target); err != nil {
+			return fmt.Errorf("failed to link issues: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Linked **%s** %s **%s**\n", fromKey, relation, toKey))
+		return nil
+	},
+}
+
+var linksRemoveCmd = &cobra.Command{
+	Use:   "remove KEY RELATION KEY",
+	Short: "Remove a link between two issues",
+	Long: `Remove the link between two issues that matches the relation, e.g.
+"PROJ-1 blocks PROJ-2". The relation may be written from either side.
+
+Example:
+  markcli atlassian jira links remove PROJ-1 blocks PROJ-2`,
+	Args: cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		fromKey, relation, toKey, err := linkArgs(args)
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		linkType, source, target, err := findLinkRelation(client, fromKey, relation, toKey)
+		if err != nil {
+			return err
+		}
+
+		issue, err := client.AtlassianJiraGetIssue(source)
+		if err != nil {
+			return fmt.Errorf("failed to get issue: %w", err)
+		}
+
+		// Seen from the source, the target of a matching link is its outward issue
+		for _, link := range issue.Fields.IssueLinks {
+			if link.Type.Name != linkType.Name || link.OutwardIssue == nil || !strings.EqualFold(link.OutwardIssue.Key, target) {
+				continue
+			}
+			if err := client.AtlassianJiraDeleteIssueLink(link.ID); err != nil {
+				return fmt.Errorf("failed to remove link: %w", err)
+			}
+			rendering.PrintMarkdown(fmt.Sprintf("Removed link **%s** %s **%s**\n", fromKey, relation, toKey))
+			return nil
+		}
+
+		return fmt.Errorf("%s does not have a %q link to %s", fromKey, relation, toKey)
+	},
+}
+
+// linkArgs splits "KEY relation words KEY" into the two issue keys and the relation
+func linkArgs(args []string) (fromKey, relation, toKey string, err error) {
+	if fromKey, err = atlassian.AtlassianJiraResolveIssueKey(args[0]); err != nil {
+		return "", "", "", err
+	}
+	if toKey, err = atlassian.AtlassianJiraResolveIssueKey(args[len(args)-1]); err != nil {
+		return "", "", "", err
+	}
+	relation = strings.Join(args[1:len(args)-1], " ")
+	return fromKey, relation, toKey, nil
+}
+
+// findLinkRelation finds the link type of a relation and orders the issues so that
+// source <outward description> target
+func findLinkRelation(client *atlassian.Client, fromKey, relation, toKey string) (linkType *types.AtlassianJiraIssueLinkType, source, target string, err error) {
+	linkTypes, err := client.AtlassianJiraGetIssueLinkTypes()
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to get link types: %w", err)
+	}
+
+	linkType, reversed, err := atlassian.AtlassianJiraFindLinkRelation(linkTypes, relation)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if reversed {
+		return linkType, toKey, fromKey, nil
+	}
+	return linkType, fromKey, toKey, nil
+}
+
+func init() {
+	Cmd.AddCommand(linksCmd)
+	linksCmd.AddCommand(linksAddCmd)
+	linksCmd.AddCommand(linksRemoveCmd)
+
+	linksAddCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+	linksRemoveCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
@@ -12,7 +12,16 @@ var Cmd = &cobra.Command{
 Available Commands:
 - issues: Search, view, and manage Jira issues
 - projects: List and filter Jira projects
-- comments: View issue comments and activity
+- search: Search issues with text, filters, or JQL
+- comments: List, add, edit, and delete issue comments
+- links: Link and unlink issues
+- boards: List agile boards
+- sprints: List, show, create, start, and close sprints
+- backlog: Show the backlog of a board
+- worklog: Log and list time spent on issues
+- timesheet: Report the time a user logged per day and per issue
+- attachments: List, download, and upload issue attachments
+- bulk: Edit, transition, or comment on every issue matching a JQL query, with undo
 
 Common Flags:
   --site: Specify which Atlassian site to use (optional)
@@ -26,9 +35,16 @@ Examples:
   markcli atlassian jira issues search -t "deployment process"
   markcli atlassian jira issues search -t "bug" -r SHOP --limit 5
 
+  # Search with filters
+  markcli atlassian jira search --assignee me --sprint current
+
   # Get issue details
   markcli atlassian jira issues get --id PROJ-123
 
+  # Show the active sprint of a board
+  markcli atlassian jira sprints list --board 42 --state active
+  markcli atlassian jira sprints show 1234
+
   # List projects with sorting
   markcli atlassian jira projects --sort name`,
 }
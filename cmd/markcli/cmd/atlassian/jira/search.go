@@ -8,8 +8,8 @@ import (
 	"markcli/internal/logging"
 	"markcli/internal/rendering"
 	types "markcli/internal/types/atlassian"
-	"markcli/internal/util"
 	"net/http"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
@@ -17,11 +17,16 @@ import (
 var searchCmd = &cobra.Command{
 	Use:   "search",
 	Short: "Search for Jira issues",
-	Long: `Search for Jira issues using text search.
+	Long: `Search for Jira issues using text search and filters, or a raw JQL query.
 
 The search will look for the given text in issue summaries, descriptions, and comments.
-You can filter results to a specific project using the -r flag.
-	
+Text is optional when filters are given. Filter values are escaped, so quotes in search
+text cannot break the query.
+
+--fields adds fields, including custom fields, to each result, and --columns shows the
+results as a table of the given fields ("key" is the issue key). Both default to the
+"jira_fields.search" and "jira_fields.columns" lists of the site configuration.
+
 Examples:
   # Basic text search
   markcli atlassian jira issues search -t "deployment process"
@@ -29,94 +34,223 @@ Examples:
   # Search in a specific project
   markcli atlassian jira issues search -t "deployment process" -r SHOP
 
+  # My open bugs in the current sprint
+  markcli atlassian jira issues search --assignee me --type Bug --status "To Do,In Progress" --sprint current
+
+  # Issues labelled "payments" updated in the last week, highest priority first
+  markcli atlassian jira issues search --label payments --updated-since 7d --order-by "priority desc"
+
+  # Raw JQL
+  markcli atlassian jira issues search --jql 'project = SHOP AND fixVersion = "2.4" ORDER BY rank'
+
+  # Show custom fields, or a table of chosen columns
+  markcli atlassian jira issues search -r SHOP --sprint current --fields "Story Points,Team"
+  markcli atlassian jira issues search -r SHOP --columns "key,summary,status,assignee,Story Points"
+
   # Search with pagination
   markcli atlassian jira issues search -t "deployment process" --limit 20 --page 2`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		text, _ := cmd.Flags().GetString("text")
-		if text == "" {
-			return fmt.Errorf("search text is required")
+	RunE: search,
+}
+
+var jiraSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search for Jira issues",
+	Long: `Search for Jira issues using text search and filters, or a raw JQL query.
+This is the same command as "jira issues search".
+
+Examples:
+  markcli atlassian jira search --assignee me --sprint current
+  markcli atlassian jira search -t "timeout" -r SHOP --created-since 2026-10-01
+  markcli atlassian jira search --jql 'assignee = currentUser() AND resolution IS EMPTY'`,
+	RunE: search,
+}
+
+// searchFilterFlags lists the flags that build the JQL query and cannot be combined with --jql
+var searchFilterFlags = []string{"text", "project", "status", "assignee", "type", "label", "sprint", "updated-since", "created-since", "order-by"}
+
+func search(cmd *cobra.Command, args []string) error {
+	jql, _ := cmd.Flags().GetString("jql")
+	text, _ := cmd.Flags().GetString("text")
+	projectKey, _ := cmd.Flags().GetString("project")
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+	assignee, _ := cmd.Flags().GetString("assignee")
+	issueTypes, _ := cmd.Flags().GetStringSlice("type")
+	labels, _ := cmd.Flags().GetStringSlice("label")
+	sprint, _ := cmd.Flags().GetString("sprint")
+	updatedSince, _ := cmd.Flags().GetString("updated-since")
+	createdSince, _ := cmd.Flags().GetString("created-since")
+	orderBy, _ := cmd.Flags().GetString("order-by")
+	fieldNames, _ := cmd.Flags().GetStringSlice("fields")
+	columnNames, _ := cmd.Flags().GetStringSlice("columns")
+	limit, _ := cmd.Flags().GetInt("limit")
+	page, _ := cmd.Flags().GetInt("page")
+	siteName, _ := cmd.Flags().GetString("site")
+
+	// A raw query replaces the generated one, so filters would be silently dropped
+	if jql != "" {
+		for _, name := range searchFilterFlags {
+			if cmd.Flags().Changed(name) {
+				return fmt.Errorf("--jql cannot be combined with --%s", name)
+			}
+		}
+	} else {
+		filtered := false
+		for _, name := range searchFilterFlags {
+			if name != "order-by" && cmd.Flags().Changed(name) {
+				filtered = true
+			}
+		}
+		if !filtered {
+			return fmt.Errorf("search text, a filter, or --jql is required")
 		}
+	}
 
-		limit, _ := cmd.Flags().GetInt("limit")
-		page, _ := cmd.Flags().GetInt("page")
-		siteName, _ := cmd.Flags().GetString("site")
-		projectKey, _ := cmd.Flags().GetString("project")
+	if limit < 1 {
+		return fmt.Errorf("--limit must be at least 1")
+	}
+	if page < 1 {
+		return fmt.Errorf("--page must be at least 1")
+	}
 
-		// Calculate start position for pagination
-		startAt := (page - 1) * limit
+	// Calculate start position for pagination
+	startAt := (page - 1) * limit
 
-		// Get Atlassian configuration
-		cfg, err := config.GetAtlassianConfig(siteName)
+	// Get Atlassian configuration
+	cfg, err := config.GetAtlassianConfig(siteName)
+	if err != nil {
+		return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+	}
+
+	// Create client
+	client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+	// Assignees given by email or name are matched by account ID
+	switch strings.ToLower(assignee) {
+	case "", "me", "none", "unassigned":
+	default:
+		user, err := client.AtlassianJiraFindUser(assignee)
 		if err != nil {
-			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+			return fmt.Errorf("failed to find assignee %s: %w", assignee, err)
 		}
+		assignee = user.AccountID
+	}
 
-		// Create client
-		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+	// Resolve the extra fields and table columns to show
+	var defaultFields, defaultColumns []string
+	if cfg.JiraFields != nil {
+		defaultFields = cfg.JiraFields.Search
+		defaultColumns = cfg.JiraFields.Columns
+	}
+	fields, fieldIDs, err := fieldSelection(client, fieldNames, defaultFields)
+	if err != nil {
+		return err
+	}
+	columns, columnIDs, err := fieldSelection(client, columnNames, defaultColumns)
+	if err != nil {
+		return err
+	}
 
-		// Build JQL query
-		jql := fmt.Sprintf("text ~ \"%s\"", text)
-		if projectKey != "" {
-			jql = fmt.Sprintf("project = %s AND %s", projectKey, jql)
-		}
-		jql += " ORDER BY updated DESC"
+	// Build JQL query
+	jql, err = atlassian.AtlassianJiraBuildSearchJQL(types.AtlassianJiraSearchFilters{
+		JQL:          jql,
+		Text:         text,
+		Project:      projectKey,
+		Statuses:     statuses,
+		Assignee:     assignee,
+		Types:        issueTypes,
+		Labels:       labels,
+		Sprint:       sprint,
+		UpdatedSince: updatedSince,
+		CreatedSince: createdSince,
+		OrderBy:      orderBy,
+	})
+	if err != nil {
+		return err
+	}
+	logging.LogDebug("JQL: %s", jql)
 
-		// Search issues
-		searchOpts := types.AtlassianJiraSearchOptions{
-			Query:   jql,
-			StartAt: startAt,
-			Limit:   limit,
-		}
-		results, err := client.AtlassianJiraSearchIssues(searchOpts)
-		if err != nil {
-			// Check for specific API errors
-			if apiErr, ok := err.(*types.AtlassianJiraError); ok {
-				switch apiErr.StatusCode {
-				case http.StatusUnauthorized:
-					return fmt.Errorf("authentication failed: please check your API token and email")
-				case http.StatusForbidden:
-					return fmt.Errorf("access denied: you don't have permission to search issues")
-				case http.StatusBadRequest:
-					return fmt.Errorf("invalid JQL query: %s", apiErr.Message)
-				default:
-					if apiErr.Message != "" {
-						return fmt.Errorf("Jira API error: %s", apiErr.Message)
-					}
+	// Search issues
+	searchOpts := types.AtlassianJiraSearchOptions{
+		Query:   jql,
+		StartAt: startAt,
+		Limit:   limit,
+		Fields:  append(fieldIDs, columnIDs...),
+	}
+	results, err := client.AtlassianJiraSearchIssues(searchOpts)
+	if err != nil {
+		// Check for specific API errors
+		if apiErr, ok := err.(*types.AtlassianJiraError); ok {
+			switch apiErr.StatusCode {
+			case http.StatusUnauthorized:
+				return fmt.Errorf("authentication failed: please check your API token and email")
+			case http.StatusForbidden:
+				return fmt.Errorf("access denied: you don't have permission to search issues")
+			case http.StatusBadRequest:
+				return fmt.Errorf("invalid JQL query: %s", apiErr.Error())
+			default:
+				if apiErr.Message != "" {
+					return fmt.Errorf("Jira API error: %s", apiErr.Message)
 				}
 			}
-			return fmt.Errorf("failed to search issues: %w", err)
 		}
+		return fmt.Errorf("failed to search issues: %w", err)
+	}
 
-		// Handle no results
-		if len(results.Issues) == 0 {
-			logging.LogDebug("No issues found for text: %s", text)
-			rendering.PrintMarkdown("No issues found.")
-			return nil
-		}
+	// Handle no results
+	if len(results.Issues) == 0 {
+		logging.LogDebug("No issues found for query: %s", jql)
+		rendering.PrintMarkdown("No issues found.")
+		return nil
+	}
+
+	// Format results
+	formatter := formatting.AtlassianJiraCreateSearchResultsFormatter(results.Issues).
+		WithFields(fields).
+		WithColumns(columns)
+	output := formatter.AtlassianJiraFormatSearchResultsAsMarkdown()
 
-		// Format results
-		formatter := formatting.AtlassianJiraCreateSearchResultsFormatter(results.Issues)
-		output := formatter.AtlassianJiraFormatSearchResultsAsMarkdown()
+	// Add pagination info; the search API only counts matches approximately
+	total := -1
+	if !results.IsLast {
+		if total, err = client.AtlassianJiraCountIssues(jql); err != nil {
+			logging.LogDebug("Failed to count issues: %v", err)
+			total = -1
+		}
+	}
+	output += fmt.Sprintf("\nShowing %s issues\n", formatting.AtlassianJiraFormatSearchRange(startAt, len(results.Issues), results.IsLast, total))
+	if !results.IsLast {
+		output += fmt.Sprintf("Use --page %d for more.\n", page+1)
+	}
 
-		// Add pagination info
-		output += fmt.Sprintf("\nShowing %d-%d of %d issues\n",
-			startAt+1,
-			util.Min(startAt+len(results.Issues), results.Total),
-			results.Total,
-		)
+	// Print the formatted output using Glamour
+	rendering.PrintMarkdown(output)
+	return nil
+}
 
-		// Print the formatted output using Glamour
-		rendering.PrintMarkdown(output)
-		return nil
-	},
+// addSearchFlags registers the text, filter, and pagination flags shared by the search commands
+func addSearchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("text", "t", "", "Search text")
+	cmd.Flags().String("jql", "", "Raw JQL query (cannot be combined with filter flags)")
+	cmd.Flags().StringP("project", "r", "", "Project key to search in (e.g., SHOP)")
+	cmd.Flags().StringSlice("status", nil, "Only include issues in these statuses (comma-separated)")
+	cmd.Flags().String("assignee", "", "Only include issues assigned to this user: email, name, account ID, \"me\", or \"none\"")
+	cmd.Flags().StringSlice("type", nil, "Only include these issue types (comma-separated)")
+	cmd.Flags().StringSlice("label", nil, "Only include issues with these labels (repeatable)")
+	cmd.Flags().String("sprint", "", "Only include issues in this sprint: ID, name, \"current\", \"future\", or \"closed\"")
+	cmd.Flags().String("updated-since", "", "Only include issues updated since a date (YYYY-MM-DD) or duration (e.g. 7d, 2w)")
+	cmd.Flags().String("created-since", "", "Only include issues created since a date (YYYY-MM-DD) or duration (e.g. 7d, 2w)")
+	cmd.Flags().String("order-by", "updated DESC", "Sort order as comma-separated fields with optional direction, e.g. \"priority desc, created\"")
+	cmd.Flags().StringSlice("fields", nil, "Extra fields to show for each issue, by name or ID (comma-separated)")
+	cmd.Flags().StringSlice("columns", nil, "Show results as a table of these fields, e.g. \"key,summary,status,Story Points\"")
+	cmd.Flags().IntP("limit", "l", 100, "Number of results per page")
+	cmd.Flags().IntP("page", "p", 1, "Page number")
+	cmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
 }
 
 func init() {
 	issuesCmd.AddCommand(searchCmd)
-	searchCmd.Flags().StringP("text", "t", "", "Search text")
-	searchCmd.Flags().StringP("project", "r", "", "Project key to search in (e.g., SHOP)")
-	searchCmd.Flags().IntP("limit", "l", 100, "Number of results per page")
-	searchCmd.Flags().IntP("page", "p", 1, "Page number")
-	searchCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
-	searchCmd.MarkFlagRequired("text")
+	addSearchFlags(searchCmd)
+
+	Cmd.AddCommand(jiraSearchCmd)
+	addSearchFlags(jiraSearchCmd)
 }
@@ -0,0 +1,503 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var sprintsCmd = &cobra.Command{
+	Use:   "sprints",
+	Short: "View and run sprints",
+	Long: `List, show, create, start, and close the sprints of a Scrum board, and move issues
+into a sprint.
+
+Story points are read from the estimation field of the board, or from the "Story Points"
+field of the site; use --points-field to choose another field.
+
+Available Commands:
+- list: List the sprints of a board
+- show: Show a sprint with its issues grouped by status
+- create: Create a sprint
+- start: Start a sprint
+- close: Close a sprint
+- move: Move issues into a sprint
+
+Examples:
+  markcli atlassian jira sprints list --board 42 --state active
+  markcli atlassian jira sprints show 1234
+  markcli atlassian jira sprints create --board 42 --name "Sprint 15" --goal "Gift cards"
+  markcli atlassian jira sprints start 1235 --duration 2w
+  markcli atlassian jira sprints move 1235 SHOP-12 SHOP-13
+  markcli atlassian jira sprints close 1234 --move-to 1235`,
+}
+
+var sprintsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the sprints of a board",
+	Long: `List the sprints of a board with their state, dates, and goal.
+
+Examples:
+  markcli atlassian jira sprints list --board 42
+  markcli atlassian jira sprints list --board 42 --state active,future`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		boardID, _ := cmd.Flags().GetInt("board")
+		states, _ := cmd.Flags().GetStringSlice("state")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if boardID <= 0 {
+			return fmt.Errorf("--board is required; use 'jira boards list' to find board IDs")
+		}
+		for i, state := range states {
+			states[i] = strings.ToLower(state)
+			switch states[i] {
+			case "future", "active", "closed":
+			default:
+				return fmt.Errorf("invalid sprint state %q: use future, active, or closed", state)
+			}
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		sprints, err := client.AtlassianJiraListSprints(boardID, states)
+		if err != nil {
+			return fmt.Errorf("failed to list sprints: %w", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateSprintsFormatter(sprints)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatSprintsAsMarkdown())
+		return nil
+	},
+}
+
+var sprintsShowCmd = &cobra.Command{
+	Use:   "show SPRINT_ID",
+	Short: "Show a sprint with its issues",
+	Long: `Show a sprint with its issues grouped by status, to-do statuses first, with the
+number of issues and story points of each status and of the whole sprint.
+
+Examples:
+  markcli atlassian jira sprints show 1234
+  markcli atlassian jira sprints show 1234 --points-field "Story point estimate"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pointsName, _ := cmd.Flags().GetString("points-field")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		sprintID, err := parseAgileID("sprint", args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		sprint, err := client.AtlassianJiraGetSprint(sprintID)
+		if err != nil {
+			return fmt.Errorf("failed to get sprint: %w", err)
+		}
+
+		pointsField, err := pointsFieldID(client, pointsName, sprint.OriginBoardID)
+		if err != nil {
+			return err
+		}
+
+		issues, err := client.AtlassianJiraGetSprintIssues(sprintID, pointsField)
+		if err != nil {
+			return fmt.Errorf("failed to get sprint issues: %w", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateSprintFormatter(*sprint, issues).WithPointsField(pointsField)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatSprintAsMarkdown())
+		return nil
+	},
+}
+
+var sprintsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a sprint",
+	Long: `Create a future sprint on a board. Dates are optional and can also be set when the
+sprint is started.
+
+Dates are given as YYYY-MM-DD or "YYYY-MM-DD HH:MM" in local time. --duration sets the
+end date relative to the start date, e.g. 2w or 10d.
+
+Examples:
+  markcli atlassian jira sprints create --board 42 --name "Sprint 15"
+  markcli atlassian jira sprints create --board 42 --name "Sprint 15" --start 2026-10-19 --duration 2w --goal "Gift cards"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		boardID, _ := cmd.Flags().GetInt("board")
+		name, _ := cmd.Flags().GetString("name")
+		goal, _ := cmd.Flags().GetString("goal")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if boardID <= 0 {
+			return fmt.Errorf("--board is required; use 'jira boards list' to find board IDs")
+		}
+		if name == "" {
+			return fmt.Errorf("--name is required")
+		}
+
+		start, end, err := sprintDates(cmd, "")
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		sprint, err := client.AtlassianJiraCreateSprint(boardID, name, start, end, goal)
+		if err != nil {
+			return fmt.Errorf("failed to create sprint: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Created sprint **%s** (ID: %d) on board %d\n", sprint.Name, sprint.ID, boardID))
+		return nil
+	},
+}
+
+var sprintsStartCmd = &cobra.Command{
+	Use:   "start SPRINT_ID",
+	Short: "Start a sprint",
+	Long: `Start a future sprint. The sprint starts now unless it already has a start date or
+--start is given, and ends after --duration (default two weeks) unless it already has an
+end date or --end is given.
+
+Examples:
+  markcli atlassian jira sprints start 1235
+  markcli atlassian jira sprints start 1235 --duration 1w --goal "Ship gift cards"
+  markcli atlassian jira sprints start 1235 --end 2026-10-30`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goal, _ := cmd.Flags().GetString("goal")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		sprintID, err := parseAgileID("sprint", args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		sprint, err := client.AtlassianJiraGetSprint(sprintID)
+		if err != nil {
+			return fmt.Errorf("failed to get sprint: %w", err)
+		}
+		if sprint.State != "future" {
+			return fmt.Errorf("sprint %s is %s; only future sprints can be started", sprint.Name, sprint.State)
+		}
+
+		// Fill in the dates Jira needs to start a sprint
+		startDefault := sprint.StartDate
+		if startDefault == "" {
+			startDefault = time.Now().Format(time.RFC3339)
+		}
+		start, end, err := sprintDates(cmd, startDefault)
+		if err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("end") && !cmd.Flags().Changed("duration") && sprint.EndDate != "" {
+			end = sprint.EndDate
+		}
+
+		changes := map[string]interface{}{
+			"state":     "active",
+			"startDate": start,
+			"endDate":   end,
+		}
+		if goal != "" {
+			changes["goal"] = goal
+		}
+
+		sprint, err = client.AtlassianJiraUpdateSprint(sprintID, changes)
+		if err != nil {
+			return fmt.Errorf("failed to start sprint: %w", err)
+		}
+
+		if t, err := util.ParseDate(end); err == nil {
+			end = t.Format("Jan 02, 2006 15:04")
+		}
+		rendering.PrintMarkdown(fmt.Sprintf("Started sprint **%s** (ID: %d), ending %s\n", sprint.Name, sprint.ID, end))
+		return nil
+	},
+}
+
+var sprintsCloseCmd = &cobra.Command{
+	Use:   "close SPRINT_ID",
+	Short: "Close a sprint",
+	Long: `Close an active sprint. Issues that are not done can be moved to another sprint with
+--move-to; otherwise Jira returns them to the backlog. Asks for confirmation unless
+--yes is given.
+
+Examples:
+  markcli atlassian jira sprints close 1234
+  markcli atlassian jira sprints close 1234 --move-to 1235 --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		moveTo, _ := cmd.Flags().GetInt("move-to")
+		yes, _ := cmd.Flags().GetBool("yes")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		sprintID, err := parseAgileID("sprint", args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		sprint, err := client.AtlassianJiraGetSprint(sprintID)
+		if err != nil {
+			return fmt.Errorf("failed to get sprint: %w", err)
+		}
+		if sprint.State != "active" {
+			return fmt.Errorf("sprint %s is %s; only active sprints can be closed", sprint.Name, sprint.State)
+		}
+
+		issues, err := client.AtlassianJiraGetSprintIssues(sprintID)
+		if err != nil {
+			return fmt.Errorf("failed to get sprint issues: %w", err)
+		}
+		var incomplete []string
+		for _, issue := range issues {
+			if issue.Fields.Status.StatusCategory.Key != "done" {
+				incomplete = append(incomplete, issue.Key)
+			}
+		}
+
+		destination := "the backlog"
+		if moveTo > 0 {
+			destination = fmt.Sprintf("sprint %d", moveTo)
+		}
+		if !yes && !util.Confirm(fmt.Sprintf("Close sprint %s? %d of %d issues are not done and will move to %s.", sprint.Name, len(incomplete), len(issues), destination)) {
+			return fmt.Errorf("aborted")
+		}
+
+		if moveTo > 0 && len(incomplete) > 0 {
+			if err := client.AtlassianJiraMoveIssuesToSprint(moveTo, incomplete); err != nil {
+				return fmt.Errorf("failed to move incomplete issues: %w", err)
+			}
+		}
+
+		if _, err := client.AtlassianJiraUpdateSprint(sprintID, map[string]interface{}{"state": "closed"}); err != nil {
+			return fmt.Errorf("failed to close sprint: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Closed sprint **%s**: %d issues done, %d moved to %s\n", sprint.Name, len(issues)-len(incomplete), len(incomplete), destination))
+		return nil
+	},
+}
+
+var sprintsMoveCmd = &cobra.Command{
+	Use:   "move SPRINT_ID [KEY...]",
+	Short: "Move issues into a sprint",
+	Long: `Move issues into a sprint, given by key or with a JQL query.
+
+Examples:
+  markcli atlassian jira sprints move 1235 SHOP-12 SHOP-13
+  markcli atlassian jira sprints move 1235 --jql "project = SHOP AND labels = gift-cards AND sprint IS EMPTY"`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jql, _ := cmd.Flags().GetString("jql")
+		limit, _ := cmd.Flags().GetInt("limit")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		sprintID, err := parseAgileID("sprint", args[0])
+		if err != nil {
+			return err
+		}
+		if (len(args) == 1) == (jql == "") {
+			return fmt.Errorf("specify either issue keys or --jql")
+		}
+		if jql != "" && limit < 1 {
+			return fmt.Errorf("--limit must be at least 1")
+		}
+
+		var issueKeys []string
+		for _, ref := range args[1:] {
+			issueKey, err := atlassian.AtlassianJiraResolveIssueKey(ref)
+			if err != nil {
+				return err
+			}
+			issueKeys = append(issueKeys, issueKey)
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		if jql != "" {
+			issues, truncated, err := collectIssues(client, jql, limit)
+			if err != nil {
+				return err
+			}
+			if truncated {
+				return fmt.Errorf("more than %d issues match the query: raise --limit or narrow the query", limit)
+			}
+			for _, issue := range issues {
+				issueKeys = append(issueKeys, issue.Key)
+			}
+			if len(issueKeys) == 0 {
+				rendering.PrintMarkdown("No issues found.")
+				return nil
+			}
+		}
+
+		if err := client.AtlassianJiraMoveIssuesToSprint(sprintID, issueKeys); err != nil {
+			return fmt.Errorf("failed to move issues: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Moved %d issues into sprint %d: %s\n", len(issueKeys), sprintID, strings.Join(issueKeys, ", ")))
+		return nil
+	},
+}
+
+// sprintDurationPattern matches sprint lengths in days or weeks, e.g. 10d or 2w
+var sprintDurationPattern = regexp.MustCompile(`^(\d+)([dw])$`)
+
+// sprintDates reads --start, --end, and --duration as RFC 3339 dates. startDefault is used
+// when --start is not given; the end is empty when neither it nor the start is known.
+func sprintDates(cmd *cobra.Command, startDefault string) (start, end string, err error) {
+	startText, _ := cmd.Flags().GetString("start")
+	endText, _ := cmd.Flags().GetString("end")
+	durationText, _ := cmd.Flags().GetString("duration")
+
+	if endText != "" && cmd.Flags().Changed("duration") {
+		return "", "", fmt.Errorf("use either --end or --duration, not both")
+	}
+
+	start = startDefault
+	if startText != "" {
+		t, err := parseLocalTime(startText)
+		if err != nil {
+			return "", "", err
+		}
+		start = t.Format(time.RFC3339)
+	}
+
+	if endText != "" {
+		t, err := parseLocalTime(endText)
+		if err != nil {
+			return "", "", err
+		}
+		return start, t.Format(time.RFC3339), nil
+	}
+
+	if start == "" || durationText == "" {
+		return start, "", nil
+	}
+	startTime, err := util.ParseDate(start)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid start date %q", start)
+	}
+	match := sprintDurationPattern.FindStringSubmatch(durationText)
+	if match == nil {
+		return "", "", fmt.Errorf("invalid duration %q: use days or weeks, e.g. 10d or 2w", durationText)
+	}
+	days, _ := strconv.Atoi(match[1])
+	if match[2] == "w" {
+		days *= 7
+	}
+	return start, startTime.AddDate(0, 0, days).Format(time.RFC3339), nil
+}
+
+// parseLocalTime parses a date given as YYYY-MM-DD or "YYYY-MM-DD HH:MM" in local time
+func parseLocalTime(value string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q: use YYYY-MM-DD or \"YYYY-MM-DD HH:MM\"", value)
+}
+
+// addSprintDateFlags registers the date flags of create and start
+func addSprintDateFlags(cmd *cobra.Command, defaultDuration string) {
+	cmd.Flags().String("start", "", "Start date, YYYY-MM-DD or \"YYYY-MM-DD HH:MM\"")
+	cmd.Flags().String("end", "", "End date, YYYY-MM-DD or \"YYYY-MM-DD HH:MM\"")
+	cmd.Flags().String("duration", defaultDuration, "Sprint length from the start date, e.g. 2w or 10d")
+	cmd.Flags().String("goal", "", "Sprint goal")
+	cmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
+
+func init() {
+	Cmd.AddCommand(sprintsCmd)
+	sprintsCmd.AddCommand(sprintsListCmd)
+	sprintsCmd.AddCommand(sprintsShowCmd)
+	sprintsCmd.AddCommand(sprintsCreateCmd)
+	sprintsCmd.AddCommand(sprintsStartCmd)
+	sprintsCmd.AddCommand(sprintsCloseCmd)
+	sprintsCmd.AddCommand(sprintsMoveCmd)
+
+	sprintsListCmd.Flags().Int("board", 0, "Board ID (required)")
+	sprintsListCmd.Flags().StringSlice("state", nil, "Only list sprints in these states: future, active, closed (comma-separated)")
+	sprintsListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	sprintsShowCmd.Flags().String("points-field", "", "Field holding story points, by name or ID")
+	sprintsShowCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	sprintsCreateCmd.Flags().Int("board", 0, "Board ID (required)")
+	sprintsCreateCmd.Flags().String("name", "", "Sprint name (required)")
+	addSprintDateFlags(sprintsCreateCmd, "")
+
+	addSprintDateFlags(sprintsStartCmd, "2w")
+
+	sprintsCloseCmd.Flags().Int("move-to", 0, "Sprint ID to move issues that are not done to")
+	sprintsCloseCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	sprintsCloseCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	sprintsMoveCmd.Flags().String("jql", "", "Move every issue matching this JQL query")
+	sprintsMoveCmd.Flags().Int("limit", 100, "Maximum number of issues to move with --jql; the move fails when more match")
+	sprintsMoveCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
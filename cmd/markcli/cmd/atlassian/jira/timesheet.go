@@ -0,0 +1,130 @@
+package jira
+
+import (
+	"fmt"
+	"time"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/logging"
+	"markcli/internal/rendering"
+
+	"github.com/spf13/cobra"
+)
+
+var timesheetCmd = &cobra.Command{
+	Use:   "timesheet",
+	Short: "Report the time a user logged",
+	Long: `Report the time a user logged across all issues, per day and per issue, with totals.
+
+The issues are found with the worklogAuthor and worklogDate JQL fields, then only the
+worklogs of the user started within the range are counted. The range defaults to the
+current week, Monday to today. Dates are YYYY-MM-DD and both ends are included.
+
+Use --format csv for one row per worklog, e.g. to import into a spreadsheet.
+
+Examples:
+  markcli atlassian jira timesheet
+  markcli atlassian jira timesheet --user jane@example.com --from 2026-10-12 --to 2026-10-18
+  markcli atlassian jira timesheet --from 2026-10-01 --to 2026-10-31 --project SHOP --format csv > october.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		user, _ := cmd.Flags().GetString("user")
+		fromText, _ := cmd.Flags().GetString("from")
+		toText, _ := cmd.Flags().GetString("to")
+		projectKey, _ := cmd.Flags().GetString("project")
+		format, _ := cmd.Flags().GetString("format")
+		limit, _ := cmd.Flags().GetInt("limit")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if format != "markdown" && format != "csv" {
+			return fmt.Errorf("invalid format %q: must be markdown or csv", format)
+		}
+		if limit < 1 {
+			return fmt.Errorf("--limit must be at least 1")
+		}
+
+		// Default to the current week, Monday to today
+		today := time.Now()
+		today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.Local)
+		from := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		to := today
+		var err error
+		if fromText != "" {
+			if from, err = time.ParseInLocation("2006-01-02", fromText, time.Local); err != nil {
+				return fmt.Errorf("invalid --from date %q: use YYYY-MM-DD", fromText)
+			}
+		}
+		if toText != "" {
+			if to, err = time.ParseInLocation("2006-01-02", toText, time.Local); err != nil {
+				return fmt.Errorf("invalid --to date %q: use YYYY-MM-DD", toText)
+			}
+		}
+		if to.Before(from) {
+			return fmt.Errorf("--to must not be before --from")
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		account, err := client.AtlassianJiraFindUser(user)
+		if err != nil {
+			return fmt.Errorf("failed to find user %s: %w", user, err)
+		}
+
+		jql := atlassian.AtlassianJiraNewJQLBuilder().
+			Where("worklogAuthor", "=", account.AccountID).
+			Where("worklogDate", ">=", from.Format("2006-01-02")).
+			Where("worklogDate", "<=", to.Format("2006-01-02"))
+		if projectKey != "" {
+			jql.Where("project", "=", projectKey)
+		}
+		logging.LogDebug("JQL: %s", jql.String())
+
+		issues, truncated, err := collectIssues(client, jql.OrderBy("key", "ASC").String(), limit)
+		if err != nil {
+			return err
+		}
+		// Totals over part of the issues would be wrong without saying so
+		if truncated {
+			return fmt.Errorf("more than %d issues have worklogs in this period: raise --limit or narrow the dates", limit)
+		}
+
+		// Worklogs are counted up to the end of the last day
+		entries, err := client.AtlassianJiraGetTimesheet(issues, account.AccountID, from, to.AddDate(0, 0, 1))
+		if err != nil {
+			return err
+		}
+
+		formatter := formatting.AtlassianJiraCreateTimesheetFormatter(account.DisplayName, from, to, entries)
+		if format == "csv" {
+			output, err := formatter.AtlassianJiraFormatTimesheetAsCSV()
+			if err != nil {
+				return fmt.Errorf("failed to write CSV: %w", err)
+			}
+			fmt.Print(output)
+			return nil
+		}
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatTimesheetAsMarkdown())
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(timesheetCmd)
+	timesheetCmd.Flags().String("user", "me", "User whose time to report: email, name, account ID, or \"me\"")
+	timesheetCmd.Flags().String("from", "", "First day, YYYY-MM-DD (defaults to Monday of this week)")
+	timesheetCmd.Flags().String("to", "", "Last day, YYYY-MM-DD (defaults to today)")
+	timesheetCmd.Flags().StringP("project", "r", "", "Only count issues of this project")
+	timesheetCmd.Flags().String("format", "markdown", "Output format: markdown or csv")
+	timesheetCmd.Flags().Int("limit", 1000, "Maximum number of issues to collect worklogs from; the report fails when more match")
+	timesheetCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
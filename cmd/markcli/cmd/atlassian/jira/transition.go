@@ -0,0 +1,292 @@
+package jira
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+	"markcli/internal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var transitionCmd = &cobra.Command{
+	Use:   "transition [KEY]",
+	Short: "Move issues through their workflow",
+	Long: `Move an issue to another status. Without --to, the transitions available on the
+issue are listed together with the fields their screens require.
+
+The target is matched by transition name first (e.g. "Start Progress"), then by the
+name of the status it leads to (e.g. "In Review"). Screen fields are set with --field
+or --resolution; required fields that are still missing are prompted for when running
+in a terminal.
+
+With --jql, every matching issue is transitioned and the outcome is reported per
+issue. Use --dry-run to preview which transition each issue would take, or "bulk transition"
+to keep a journal that can undo the run.
+
+Examples:
+  markcli atlassian jira issues transition PROJ-123
+  markcli atlassian jira issues transition PROJ-123 --to "In Review"
+  markcli atlassian jira issues transition PROJ-123 --to Done --resolution Done --comment-file note.md
+  markcli atlassian jira issues transition PROJ-123 --to Closed --field "Fix versions=2.4"
+  markcli atlassian jira issues transition --jql "project = PROJ AND status = 'In Review'" --to Done --dry-run`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, _ := cmd.Flags().GetString("to")
+		resolution, _ := cmd.Flags().GetString("resolution")
+		commentFile, _ := cmd.Flags().GetString("comment-file")
+		fieldPairs, _ := cmd.Flags().GetStringArray("field")
+		jql, _ := cmd.Flags().GetString("jql")
+		limit, _ := cmd.Flags().GetInt("limit")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		yes, _ := cmd.Flags().GetBool("yes")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		if (len(args) == 0) == (jql == "") {
+			return fmt.Errorf("specify either an issue key or --jql")
+		}
+		if jql != "" && target == "" {
+			return fmt.Errorf("--to is required with --jql")
+		}
+		if jql != "" && limit < 1 {
+			return fmt.Errorf("--limit must be at least 1")
+		}
+
+		values, err := util.ParseKeyValuePairs(fieldPairs)
+		if err != nil {
+			return err
+		}
+		if resolution != "" {
+			values["resolution"] = resolution
+		}
+
+		update, err := transitionCommentUpdate(commentFile)
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		if jql != "" {
+			opts := bulkOptions{operation: "transition", jql: jql, limit: limit, concurrency: defaultBulkConcurrency, dryRun: dryRun, yes: yes}
+			return transitionIssues(client, cfg.BaseURL, opts, target, values, update)
+		}
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		transitions, err := client.AtlassianJiraGetTransitions(issueKey)
+		if err != nil {
+			return fmt.Errorf("failed to get transitions: %w", err)
+		}
+
+		if target == "" {
+			formatter := formatting.AtlassianJiraCreateTransitionsFormatter(transitions)
+			output := fmt.Sprintf("# Transitions of %s\n\n%s", issueKey, formatter.AtlassianJiraFormatTransitionsAsMarkdown())
+
+			// Print the formatted output using Glamour
+			rendering.PrintMarkdown(output)
+			return nil
+		}
+
+		transition, err := atlassian.AtlassianJiraFindTransition(transitions, target)
+		if err != nil {
+			return fmt.Errorf("cannot transition %s: %w", issueKey, err)
+		}
+
+		fields, err := transitionFields(client, *transition, values, true)
+		if err != nil {
+			return fmt.Errorf("cannot transition %s:\n  - %w", issueKey, err)
+		}
+
+		if dryRun {
+			rendering.PrintMarkdown(fmt.Sprintf("Would move **%s** to **%s** via %s\n", issueKey, transition.To.Name, transition.Name))
+			return nil
+		}
+
+		if err := client.AtlassianJiraTransitionIssue(issueKey, transition.ID, fields, update); err != nil {
+			return fmt.Errorf("failed to transition issue: %w", err)
+		}
+
+		output := fmt.Sprintf("Moved **%s** to **%s** via %s\n\n%s/browse/%s\n", issueKey, transition.To.Name, transition.Name, cfg.BaseURL, issueKey)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(output)
+		return nil
+	},
+}
+
+// transitionIssues applies a transition to every issue matching a JQL query and reports the outcome per issue
+func transitionIssues(client *atlassian.Client, baseURL string, opts bulkOptions, target string, values map[string]string, update map[string][]map[string]interface{}) error {
+	return runBulk(client, baseURL, opts, "Transition", func(issue types.AtlassianJiraIssue, result *types.AtlassianJiraIssueResult) bulkChange {
+		result.Change = fmt.Sprintf("%s → %s", issue.Fields.Status.Name, target)
+		if strings.EqualFold(issue.Fields.Status.Name, target) {
+			result.Status = "skipped"
+			result.Message = "already in this status"
+			return nil
+		}
+
+		transitions, err := client.AtlassianJiraGetTransitions(issue.Key)
+		if err != nil {
+			result.Status = "failed"
+			result.Message = err.Error()
+			return nil
+		}
+		transition, err := atlassian.AtlassianJiraFindTransition(transitions, target)
+		if err != nil {
+			result.Status = "skipped"
+			result.Message = err.Error()
+			return nil
+		}
+		fields, err := transitionFields(client, *transition, values, false)
+		if err != nil {
+			result.Status = "failed"
+			result.Message = err.Error()
+			return nil
+		}
+
+		result.Change = fmt.Sprintf("%s → %s (%s)", issue.Fields.Status.Name, transition.To.Name, transition.Name)
+		return func() (*types.AtlassianJiraBulkJournalEntry, error) {
+			if err := client.AtlassianJiraTransitionIssue(issue.Key, transition.ID, fields, update); err != nil {
+				return nil, err
+			}
+			return &types.AtlassianJiraBulkJournalEntry{Key: issue.Key, Status: issue.Fields.Status.Name}, nil
+		}
+	})
+}
+
+// transitionCommentUpdate returns the update that adds the markdown file as a comment
+// with a transition, or nil without a file
+func transitionCommentUpdate(commentFile string) (map[string][]map[string]interface{}, error) {
+	if commentFile == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(commentFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read comment file: %w", err)
+	}
+	return map[string][]map[string]interface{}{
+		"comment": {{"add": map[string]interface{}{
+			"body": types.AtlassianDocumentConvertMarkdownToDocument(string(content)),
+		}}},
+	}, nil
+}
+
+// transitionFields shapes field values for the screen of a transition. Required fields
+// without a value are prompted for when interactive is set and stdin is a terminal.
+func transitionFields(client *atlassian.Client, transition types.AtlassianJiraTransition, values map[string]string, interactive bool) (map[string]interface{}, error) {
+	meta := make([]types.AtlassianJiraFieldMeta, 0, len(transition.Fields))
+	for _, field := range transition.Fields {
+		meta = append(meta, field)
+	}
+	sort.Slice(meta, func(i, j int) bool { return meta[i].Name < meta[j].Name })
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make(map[string]interface{})
+	var problems []string
+	for _, name := range names {
+		field, ok := atlassian.AtlassianJiraFindFieldMeta(meta, name)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s is not on the screen of the %q transition", name, transition.Name))
+			continue
+		}
+		value, err := client.AtlassianJiraFieldValue(*field, values[name])
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		fields[field.ID()] = value
+	}
+
+	for _, field := range meta {
+		if !field.Required || field.HasDefaultValue {
+			continue
+		}
+		if _, ok := fields[field.ID()]; ok {
+			continue
+		}
+
+		var allowed []string
+		for _, value := range field.AllowedValues {
+			allowed = append(allowed, value.Label())
+		}
+
+		question := field.Name
+		if len(allowed) > 0 {
+			question += fmt.Sprintf(" (%s)", strings.Join(allowed, ", "))
+		}
+		if interactive {
+			if answer, ok := util.Prompt(question); ok && answer != "" {
+				value, err := client.AtlassianJiraFieldValue(field, answer)
+				if err != nil {
+					problems = append(problems, err.Error())
+					continue
+				}
+				fields[field.ID()] = value
+				continue
+			}
+		}
+		problems = append(problems, fmt.Sprintf("%s is required by the %q transition; use --field", question, transition.Name))
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(problems, "\n  - "))
+	}
+	return fields, nil
+}
+
+// collectIssues returns the issues matching a JQL query, following pagination up to limit issues.
+// truncated reports whether more issues match than were collected.
+func collectIssues(client *atlassian.Client, jql string, limit int) (issues []types.AtlassianJiraIssue, truncated bool, err error) {
+	token := ""
+	for len(issues) < limit {
+		result, err := client.AtlassianJiraSearchIssues(types.AtlassianJiraSearchOptions{
+			Query:         jql,
+			Limit:         util.Min(100, limit-len(issues)),
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to search issues: %w", err)
+		}
+		issues = append(issues, result.Issues...)
+		if result.IsLast || len(result.Issues) == 0 {
+			return issues, false, nil
+		}
+		token = result.NextPageToken
+	}
+	return issues, true, nil
+}
+
+func init() {
+	issuesCmd.AddCommand(transitionCmd)
+	transitionCmd.Flags().String("to", "", "Target status or transition name; omit to list the available transitions")
+	transitionCmd.Flags().String("resolution", "", "Resolution to set (e.g., Done, Won't Do)")
+	transitionCmd.Flags().String("comment-file", "", "Markdown file with a comment to add with the transition")
+	transitionCmd.Flags().StringArray("field", nil, "Screen field as name=value, by field name or ID; repeatable")
+	transitionCmd.Flags().String("jql", "", "Transition every issue matching this JQL query")
+	transitionCmd.Flags().Int("limit", 100, "Maximum number of issues to transition with --jql (at least 1)")
+	transitionCmd.Flags().Bool("dry-run", false, "Show what would be transitioned without changing anything")
+	transitionCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt in bulk mode")
+	transitionCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
@@ -0,0 +1,61 @@
+package jira
+
+import (
+	"fmt"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+
+	"github.com/spf13/cobra"
+)
+
+var treeCmd = &cobra.Command{
+	Use:   "tree KEY",
+	Short: "Show an issue with its child issues",
+	Long: `Show an issue and its descendants as a tree, e.g. an epic with its stories and
+their subtasks, with the type, status, and assignee of every issue.
+
+--depth limits how many levels below the issue are fetched.
+
+Examples:
+  markcli atlassian jira issues tree SHOP-100
+  markcli atlassian jira issues tree SHOP-100 --depth 1`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		depth, _ := cmd.Flags().GetInt("depth")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		root, err := client.AtlassianJiraGetIssueTree(issueKey, depth)
+		if err != nil {
+			return fmt.Errorf("failed to get issue tree: %w", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateIssueTreeFormatter(*root)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatIssueTreeAsMarkdown())
+		return nil
+	},
+}
+
+func init() {
+	issuesCmd.AddCommand(treeCmd)
+	treeCmd.Flags().Int("depth", 3, "Number of levels of child issues to show")
+	treeCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
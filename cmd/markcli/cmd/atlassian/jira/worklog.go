@@ -0,0 +1,162 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	formatting "markcli/internal/formatting/atlassian"
+	"markcli/internal/rendering"
+	types "markcli/internal/types/atlassian"
+
+	"github.com/spf13/cobra"
+)
+
+var worklogCmd = &cobra.Command{
+	Use:   "worklog",
+	Short: "Log and list time spent on issues",
+	Long: `Log time on Jira issues and list the time logged.
+
+Available Commands:
+- add: Log time on an issue
+- list: List the time logged on an issue
+
+Examples:
+  markcli atlassian jira worklog add PROJ-123 --time 1h30m --comment "Code review"
+  markcli atlassian jira worklog add PROJ-123 --time 2h --started "2026-10-15 09:00"
+  markcli atlassian jira worklog list PROJ-123`,
+}
+
+var worklogAddCmd = &cobra.Command{
+	Use:   "add KEY",
+	Short: "Log time on an issue",
+	Long: `Log time on an issue. --time takes Jira durations such as 1h30m, 45m, or 1d; days
+and weeks follow the working hours configured on the site.
+
+The work starts now unless --started is given as YYYY-MM-DD or "YYYY-MM-DD HH:MM" in
+local time.
+
+Examples:
+  markcli atlassian jira worklog add PROJ-123 --time 1h30m
+  markcli atlassian jira worklog add PROJ-123 --time 2h --started "2026-10-15 09:00" --comment "Pairing on the checkout bug"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		timeText, _ := cmd.Flags().GetString("time")
+		startedText, _ := cmd.Flags().GetString("started")
+		commentText, _ := cmd.Flags().GetString("comment")
+		siteName, _ := cmd.Flags().GetString("site")
+
+		timeSpent, err := jiraTimeSpent(timeText)
+		if err != nil {
+			return err
+		}
+
+		started := time.Now()
+		if startedText != "" {
+			if started, err = parseLocalTime(startedText); err != nil {
+				return err
+			}
+		}
+
+		var comment *types.AtlassianDocument
+		if strings.TrimSpace(commentText) != "" {
+			comment = types.AtlassianDocumentConvertMarkdownToDocument(commentText)
+		}
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		worklog, err := client.AtlassianJiraAddWorklog(issueKey, timeSpent, started, comment)
+		if err != nil {
+			return fmt.Errorf("failed to log time: %w", err)
+		}
+
+		rendering.PrintMarkdown(fmt.Sprintf("Logged %s on **%s**, started %s (worklog %s)\n", worklog.TimeSpent, issueKey, started.Format("Jan 02, 2006 15:04"), worklog.ID))
+		return nil
+	},
+}
+
+var worklogListCmd = &cobra.Command{
+	Use:   "list KEY",
+	Short: "List the time logged on an issue",
+	Long: `List every worklog of an issue with its author, start time, time spent, and comment.
+
+Example:
+  markcli atlassian jira worklog list PROJ-123`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		issueKey, err := atlassian.AtlassianJiraResolveIssueKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Get Atlassian configuration
+		cfg, err := config.GetAtlassianConfig(siteName)
+		if err != nil {
+			return fmt.Errorf("failed to get Atlassian configuration: %w", err)
+		}
+
+		// Create client
+		client := atlassian.NewClient(cfg.BaseURL, cfg.Email, cfg.Token)
+
+		worklogs, err := client.AtlassianJiraGetWorklogs(issueKey, time.Time{}, time.Time{})
+		if err != nil {
+			return fmt.Errorf("failed to get worklogs: %w", err)
+		}
+
+		formatter := formatting.AtlassianJiraCreateWorklogsFormatter(issueKey, worklogs)
+
+		// Print the formatted output using Glamour
+		rendering.PrintMarkdown(formatter.AtlassianJiraFormatWorklogsAsMarkdown())
+		return nil
+	},
+}
+
+// jiraDurationPart matches one unit of a Jira duration, e.g. 1h or 1.5d
+var jiraDurationPart = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*([wdhm])`)
+
+// jiraTimeSpent turns a duration such as "1h30m" into the "1h 30m" form Jira expects
+func jiraTimeSpent(value string) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return "", fmt.Errorf("--time is required, e.g. --time 1h30m")
+	}
+
+	parts := jiraDurationPart.FindAllString(value, -1)
+	if strings.Join(parts, "") != strings.ReplaceAll(value, " ", "") {
+		return "", fmt.Errorf("invalid time %q: use weeks, days, hours, and minutes, e.g. 1h30m or 2d", value)
+	}
+	for i, part := range parts {
+		parts[i] = strings.ReplaceAll(part, " ", "")
+	}
+	return strings.Join(parts, " "), nil
+}
+
+func init() {
+	Cmd.AddCommand(worklogCmd)
+	worklogCmd.AddCommand(worklogAddCmd)
+	worklogCmd.AddCommand(worklogListCmd)
+
+	worklogAddCmd.Flags().String("time", "", "Time spent, e.g. 1h30m, 45m, or 1d (required)")
+	worklogAddCmd.Flags().String("started", "", "When the work started, YYYY-MM-DD or \"YYYY-MM-DD HH:MM\" (defaults to now)")
+	worklogAddCmd.Flags().String("comment", "", "Worklog comment in markdown")
+	worklogAddCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+
+	worklogListCmd.Flags().String("site", "", "Atlassian site to use (defaults to the default site)")
+}
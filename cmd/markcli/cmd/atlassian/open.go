@@ -0,0 +1,79 @@
+package atlassian
+
+import (
+	"fmt"
+
+	"markcli/cmd/markcli/cmd/atlassian/confluence"
+	"markcli/cmd/markcli/cmd/atlassian/jira"
+	"markcli/internal/api/atlassian"
+	"markcli/internal/config"
+	"markcli/internal/logging"
+
+	"github.com/spf13/cobra"
+)
+
+var openCmd = &cobra.Command{
+	Use:   "open <url-or-ref>",
+	Short: "Show the Confluence page or Jira issue behind a URL or reference",
+	Long: `Show the Confluence page or Jira issue behind a URL or reference.
+
+Accepted references:
+- Confluence page and blog post URLs, including viewpage.action links
+  (whiteboards, databases and other content types are not supported)
+- Tiny links such as https://mycompany.atlassian.net/wiki/x/AbCd or /x/AbCd
+- Legacy display/SPACE/Title links and SPACE:Title
+- Jira browse and board URLs, and issue keys such as PROJ-123
+- Numeric Confluence page IDs
+
+For URLs, the configured site whose base URL has the same host is used,
+unless --site is given. When no configured site matches, the default site is used.
+
+Examples:
+  markcli atlassian open https://mycompany.atlassian.net/wiki/spaces/ENG/pages/123456/Runbook
+  markcli atlassian open https://mycompany.atlassian.net/wiki/x/AbCd
+  markcli atlassian open "ENG:Release Process"
+  markcli atlassian open https://mycompany.atlassian.net/browse/PROJ-123`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		siteName, _ := cmd.Flags().GetString("site")
+
+		ref, err := atlassian.AtlassianParseReference(args[0])
+		if err != nil {
+			return err
+		}
+
+		// Pick the site that serves the URL
+		if siteName == "" && ref.Host != "" {
+			siteName, err = config.FindAtlassianSiteByHost(ref.Host)
+			if err != nil {
+				logging.LogDebug("Using the default site: %v", err)
+			} else {
+				logging.LogDebug("Using site %s for host %s", siteName, ref.Host)
+			}
+		}
+
+		// Show the page or issue the way the get command of the product does
+		switch ref.Product {
+		case "confluence":
+			if ref.ContentType == "blogpost" {
+				return confluence.PrintBlogPost(siteName, ref.PageID)
+			}
+			if ref.ContentType != "" && ref.ContentType != "page" {
+				return fmt.Errorf("%s links are not supported: only pages, blog posts and Jira issues can be opened", ref.ContentType)
+			}
+			pageRef := ref.PageID
+			if pageRef == "" {
+				pageRef = fmt.Sprintf("%s:%s", ref.SpaceKey, ref.Title)
+			}
+			return confluence.PrintPage(siteName, pageRef)
+		case "jira":
+			return jira.PrintIssue(siteName, ref.IssueKey, "oldest", nil)
+		}
+		return fmt.Errorf("unsupported reference: %s", args[0])
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(openCmd)
+	openCmd.Flags().String("site", "", "Atlassian site to use (defaults to the site matching the URL, then the default site)")
+}
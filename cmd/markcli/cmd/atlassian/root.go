@@ -18,6 +18,7 @@ This command provides functionality to work with various Atlassian products:
 - Confluence: Manage spaces, pages, and content
 - Jira: Manage issues, projects, and workflows
 - Global Search: Search across both Confluence and Jira
+- Open: Show the page or issue behind a pasted URL or reference
 
 Common Flags:
   --site: Specify which Atlassian site to use (optional)
@@ -33,7 +34,10 @@ Examples:
 
   # Work with Jira
   markcli atlassian jira issues search -t "deployment"
-  markcli atlassian jira issues get --id PROJ-123`,
+  markcli atlassian jira issues get --id PROJ-123
+
+  # Open a pasted link
+  markcli atlassian open https://mycompany.atlassian.net/wiki/x/AbCd`,
 }
 
 func init() {
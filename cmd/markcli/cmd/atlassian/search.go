@@ -76,7 +76,7 @@ Examples:
 		var jiraResults []types.AtlassianJiraIssue
 		if !confluenceOnly {
 			results, err := client.AtlassianJiraSearchIssues(types.AtlassianJiraSearchOptions{
-				Query: fmt.Sprintf("text ~ \"%s\"", text),
+				Query: "text ~ " + atlassian.AtlassianJiraJQLQuote(text),
 				Limit: limit,
 			})
 			if err != nil {
@@ -93,9 +93,9 @@ func search(cmd *cobra.Command, args []string) error {
 	}()
 
 	// Search Jira issues
+	jql := "text ~ " + atlassian.AtlassianJiraJQLQuote(text)
 	go func() {
 		defer wg.Done()
-		jql := fmt.Sprintf("text ~ \"%s\"", text)
 		searchOpts := types.AtlassianJiraSearchOptions{
 			Query:   jql,
 			StartAt: startAt,
@@ -154,10 +154,14 @@ func search(cmd *cobra.Command, args []string) error {
 		output += "Type: Jira Issue\n\n"
 		formatter := formatting.AtlassianJiraCreateSearchResultsFormatter(jiraResults.Issues)
 		output += formatter.AtlassianJiraFormatSearchResultsAsMarkdown()
-		output += fmt.Sprintf("\nShowing results %d-%d of %d (Jira)\n",
-			startAt+1,
-			min(startAt+len(jiraResults.Issues), jiraResults.Total),
-			jiraResults.Total,
+		total := -1
+		if !jiraResults.IsLast {
+			if count, err := client.AtlassianJiraCountIssues(jql); err == nil {
+				total = count
+			}
+		}
+		output += fmt.Sprintf("\nShowing results %s (Jira)\n",
+			formatting.AtlassianJiraFormatSearchRange(startAt, len(jiraResults.Issues), jiraResults.IsLast, total),
 		)
 	}
 
@@ -6,15 +6,36 @@ import (
 	"fmt"
 	"io"
 	"markcli/internal/logging"
+	"markcli/internal/types/atlassian"
+	"mime/multipart"
 	"net/http"
+	"strconv"
+	"sync"
+	"time"
 )
 
+// maxRateLimitRetries is how often a request answered with 429 Too Many Requests is retried
+const maxRateLimitRetries = 5
+
 // Client represents an Atlassian API client
 type Client struct {
 	baseURL    string
 	email      string
 	token      string
 	httpClient *http.Client
+
+	// userCache holds Confluence users already resolved by account ID
+	userMu    sync.Mutex
+	userCache map[string]atlassian.AtlassianConfluenceUser
+
+	// fieldCatalog holds the Jira field catalog once it has been loaded
+	fieldMu      sync.Mutex
+	fieldCatalog []atlassian.AtlassianJiraField
+
+	// pauseUntil holds back every request after the API asked to slow down, so
+	// concurrent callers back off together instead of each running into the limit
+	pauseMu    sync.Mutex
+	pauseUntil time.Time
 }
 
 // NewClient creates a new Atlassian API client
@@ -24,22 +45,72 @@ func NewClient(baseURL, email, token string) *Client {
 		email:      email,
 		token:      token,
 		httpClient: &http.Client{},
+		userCache:  make(map[string]atlassian.AtlassianConfluenceUser),
+	}
+}
+
+// rawBody is a request body that is sent as-is instead of being encoded as JSON,
+// such as a multipart upload streamed from disk
+type rawBody struct {
+	reader      io.Reader
+	contentType string
+	headers     map[string]string
+}
+
+// newMultipartBody streams a file as the given multipart form field, followed by any extra
+// form fields. The form is written through a pipe so large files are never held in memory.
+func newMultipartBody(fieldName, fileName string, content io.Reader, fields map[string]string) rawBody {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		part, err := writer.CreateFormFile(fieldName, fileName)
+		if err == nil {
+			_, err = io.Copy(part, content)
+		}
+		for name, value := range fields {
+			if err == nil {
+				err = writer.WriteField(name, value)
+			}
+		}
+		if err == nil {
+			err = writer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return rawBody{
+		reader:      pr,
+		contentType: writer.FormDataContentType(),
+		// Atlassian rejects multipart requests without this header as a CSRF safeguard
+		headers: map[string]string{"X-Atlassian-Token": "no-check"},
 	}
 }
 
-// newRequest creates a new HTTP request with authentication and common headers
+// newRequest creates a new HTTP request with authentication and common headers.
+// Bodies are encoded as JSON unless given as a rawBody.
 func (c *Client) newRequest(method, path string, body interface{}) (*http.Request, error) {
 	// Build full URL
 	reqURL := fmt.Sprintf("%s%s", c.baseURL, path)
 
 	// Create request body if provided
 	var buf io.Reader
-	if body != nil {
+	contentType := ""
+	var headers map[string]string
+	switch b := body.(type) {
+	case nil:
+	case rawBody:
+		buf = b.reader
+		contentType = b.contentType
+		headers = b.headers
+		logging.LogDebug("Request Body: <%s>", b.contentType)
+	default:
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		buf = bytes.NewBuffer(jsonBody)
+		contentType = "application/json"
 		logging.LogDebug("Request Body: %s", string(jsonBody))
 	}
 
@@ -52,9 +123,174 @@ func (c *Client) newRequest(method, path string, body interface{}) (*http.Reques
 	// Set common headers
 	req.SetBasicAuth(c.email, c.token)
 	req.Header.Set("Accept", "application/json")
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
 	}
 
 	return req, nil
 }
+
+// send sends the request, retrying it while the API answers 429 Too Many Requests. The wait
+// follows the Retry-After header, doubling from one second without it. Streamed bodies such
+// as uploads cannot be sent twice, so their 429 responses are returned as they are.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		c.pauseMu.Lock()
+		wait := time.Until(c.pauseUntil)
+		c.pauseMu.Unlock()
+		if wait > 0 {
+			time.Sleep(wait)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusTooManyRequests || attempt == maxRateLimitRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		wait = retryAfter(resp.Header.Get("Retry-After"), attempt)
+		logging.LogDebug("Rate limited on %s %s, retrying in %s", req.Method, req.URL.String(), wait)
+		c.pauseMu.Lock()
+		if until := time.Now().Add(wait); until.After(c.pauseUntil) {
+			c.pauseUntil = until
+		}
+		c.pauseMu.Unlock()
+
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// retryAfter returns how long to wait before retrying a rate-limited request, from a
+// Retry-After header in seconds or as an HTTP date, or by backing off exponentially
+func retryAfter(header string, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return time.Until(t)
+	}
+	return time.Second << attempt
+}
+
+// doRequest sends the request, logs the exchange and returns the raw response body.
+// Responses outside the 2xx range are returned as an error built by newAPIError.
+func (c *Client) doRequest(req *http.Request, newAPIError func(statusCode int, body []byte) error) ([]byte, error) {
+	// Send request
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Read response body for logging and error handling
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	// Log request and response for debugging
+	logging.LogDebug("Request URL: %s %s", req.Method, req.URL.String())
+	logging.LogDebug("Response Status: %s", resp.Status)
+	if logging.IsDebugEnabled() && len(body) > 0 {
+		var jsonData interface{}
+		if err := json.Unmarshal(body, &jsonData); err == nil {
+			logging.LogJSONInline("Response Body", jsonData)
+		} else {
+			logging.LogDebug("Response Body: %s", string(body))
+		}
+	}
+
+	// Check response status
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newAPIError(resp.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+// doStreamRequest sends the request and returns the response without reading its body,
+// so large downloads can be streamed. The caller must close the response body.
+// Responses outside the 2xx range are returned as an error built by newAPIError.
+func (c *Client) doStreamRequest(req *http.Request, newAPIError func(statusCode int, body []byte) error) (*http.Response, error) {
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	logging.LogDebug("Request URL: %s %s", req.Method, req.URL.String())
+	logging.LogDebug("Response Status: %s", resp.Status)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, newAPIError(resp.StatusCode, body)
+	}
+
+	return resp, nil
+}
+
+// doConfluenceRequest sends a Confluence request and decodes the JSON response into result.
+// A nil result discards the response body.
+func (c *Client) doConfluenceRequest(req *http.Request, result interface{}) error {
+	body, err := c.doRequest(req, newConfluenceError)
+	if err != nil {
+		return err
+	}
+	return decodeResponse(body, result)
+}
+
+// doJiraRequest sends a Jira request and decodes the JSON response into result.
+// A nil result discards the response body.
+func (c *Client) doJiraRequest(req *http.Request, result interface{}) error {
+	body, err := c.doRequest(req, newJiraError)
+	if err != nil {
+		return err
+	}
+	return decodeResponse(body, result)
+}
+
+// decodeResponse parses a JSON response body into result
+func decodeResponse(body []byte, result interface{}) error {
+	if result == nil || len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		logging.LogDebug("Failed to decode response: %s", string(body))
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// newConfluenceError builds an error from a failed Confluence response
+func newConfluenceError(statusCode int, body []byte) error {
+	var errorResp atlassian.AtlassianConfluenceError
+	if err := json.Unmarshal(body, &errorResp); err == nil {
+		errorResp.StatusCode = statusCode
+		logging.LogDebug("Confluence API Error - Status Code: %d, Error: %+v", statusCode, errorResp)
+		return &errorResp
+	}
+	logging.LogDebug("Confluence API Error - Status Code: %d, Body: %s", statusCode, string(body))
+	return fmt.Errorf("unexpected status code: %d, body: %s", statusCode, string(body))
+}
+
+// newJiraError builds an error from a failed Jira response
+func newJiraError(statusCode int, body []byte) error {
+	var errorResp atlassian.AtlassianJiraError
+	if err := json.Unmarshal(body, &errorResp); err == nil {
+		errorResp.StatusCode = statusCode
+		logging.LogDebug("Jira API Error - Status Code: %d, Error: %+v", statusCode, errorResp)
+		return &errorResp
+	}
+	logging.LogDebug("Jira API Error - Status Code: %d, Body: %s", statusCode, string(body))
+	return fmt.Errorf("unexpected status code: %d, body: %s", statusCode, string(body))
+}
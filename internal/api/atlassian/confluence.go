@@ -14,26 +14,9 @@ import (
 // AtlassianConfluenceSearchPages searches for pages in Confluence
 func (c *Client) AtlassianConfluenceSearchPages(opts atlassian.AtlassianConfluenceSearchOptions) (*atlassian.AtlassianConfluenceSearchResponse, error) {
 	// Build CQL query
-	cql := "type=page"
-
-	if opts.Query != "" {
-		cql = fmt.Sprintf("%s AND text ~ \"%s\"", cql, opts.Query)
-	}
-
-	if opts.SpaceKey != "" {
-		cql = fmt.Sprintf("%s AND space=\"%s\"", cql, opts.SpaceKey)
-	}
-
-	if opts.SortBy != "" {
-		sortBy := "lastModified"
-		if opts.SortBy != "" {
-			sortBy = opts.SortBy
-		}
-		sortOrder := "desc"
-		if opts.SortOrder != "" {
-			sortOrder = opts.SortOrder
-		}
-		cql = fmt.Sprintf("%s ORDER BY %s %s", cql, sortBy, sortOrder)
+	cql, err := AtlassianConfluenceBuildSearchCQL(opts)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build query parameters
@@ -41,7 +24,7 @@ func (c *Client) AtlassianConfluenceSearchPages(opts atlassian.AtlassianConfluen
 	params.Add("cql", cql)
 	params.Add("start", fmt.Sprintf("%d", opts.StartAt))
 	params.Add("limit", fmt.Sprintf("%d", opts.Limit))
-	params.Add("expand", "content.space,content.version")
+	params.Add("expand", "content.space,content.version,content.metadata.labels")
 
 	// Create request
 	req, err := c.newRequest("GET", "/wiki/rest/api/search?"+params.Encode(), nil)
@@ -50,7 +33,7 @@ func (c *Client) AtlassianConfluenceSearchPages(opts atlassian.AtlassianConfluen
 	}
 
 	// Send request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
@@ -96,69 +79,45 @@ func (c *Client) AtlassianConfluenceSearchPages(opts atlassian.AtlassianConfluen
 	return &result, nil
 }
 
-// AtlassianConfluenceListSpaces returns a list of Confluence spaces
+// AtlassianConfluenceListSpaces returns all Confluence spaces, following pagination
 func (c *Client) AtlassianConfluenceListSpaces(includeAll bool) ([]atlassian.AtlassianConfluenceSpace, error) {
-	// Build query parameters
-	params := url.Values{}
-	params.Add("limit", "100") // Get maximum spaces per request
-	if !includeAll {
-		params.Add("type", "global")    // Only get global spaces
-		params.Add("status", "current") // Only get active spaces
-	}
-
-	// Create request
-	req, err := c.newRequest("GET", "/wiki/rest/api/space?"+params.Encode(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Send request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Read response body for logging
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
+	const pageSize = 100
+
+	var spaces []atlassian.AtlassianConfluenceSpace
+	for start := 0; ; {
+		// Build query parameters
+		params := url.Values{}
+		params.Add("start", fmt.Sprintf("%d", start))
+		params.Add("limit", fmt.Sprintf("%d", pageSize))
+		if !includeAll {
+			params.Add("type", "global")    // Only get global spaces
+			params.Add("status", "current") // Only get active spaces
+		}
 
-	// Log request and response for debugging
-	logging.LogDebug("Request URL: %s", req.URL.String())
-	logging.LogDebug("Response Status: %s", resp.Status)
-	if logging.IsDebugEnabled() {
-		var jsonData interface{}
-		if err := json.Unmarshal(body, &jsonData); err == nil {
-			logging.LogJSONInline("Response Body", jsonData)
-		} else {
-			logging.LogDebug("Response Body: %s", string(body))
+		// Create request
+		req, err := c.newRequest("GET", "/wiki/rest/api/space?"+params.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
-	}
 
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		var errorResp atlassian.AtlassianConfluenceError
-		if err := json.Unmarshal(body, &errorResp); err == nil {
-			errorResp.StatusCode = resp.StatusCode
-			logging.LogDebug("Confluence API Error - Status Code: %d, Error: %+v", resp.StatusCode, errorResp)
-			return nil, &errorResp
+		var result struct {
+			Results []atlassian.AtlassianConfluenceSpace `json:"results"`
+			Links   atlassian.AtlassianConfluenceLinks   `json:"_links"`
+		}
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
 		}
-		logging.LogDebug("Confluence API Error - Status Code: %d, Body: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
-	}
 
-	// Parse response
-	var result struct {
-		Results []atlassian.AtlassianConfluenceSpace `json:"results"`
-	}
-	if err := json.Unmarshal(body, &result); err != nil {
-		logging.LogDebug("Failed to decode response: %s", string(body))
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		spaces = append(spaces, result.Results...)
+
+		// The next link is only present while more spaces remain
+		if result.Links.Next == "" || len(result.Results) == 0 {
+			break
+		}
+		start += len(result.Results)
 	}
 
-	return result.Results, nil
+	return spaces, nil
 }
 
 // AtlassianConfluenceGetPage gets a specific page by ID from Confluence API v2
@@ -185,7 +144,7 @@ func (c *Client) AtlassianConfluenceGetPage(pageID string) (*atlassian.Atlassian
 	logging.LogDebug("Request Headers: %v", req.Header)
 
 	// Send request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
@@ -230,84 +189,3 @@ func (c *Client) AtlassianConfluenceGetPage(pageID string) (*atlassian.Atlassian
 
 	return &result, nil
 }
-
-// AtlassianConfluenceGetPageFooterComments retrieves footer comments for a specific page
-func (c *Client) AtlassianConfluenceGetPageFooterComments(pageID string) (*atlassian.AtlassianConfluenceFooterCommentsResponse, error) {
-	endpoint := fmt.Sprintf("/wiki/api/v2/pages/%s/footer-comments", pageID)
-
-	// Build query parameters
-	params := make(map[string]string)
-	params["body-format"] = "atlas_doc_format"
-
-	// Add query parameters to endpoint
-	if len(params) > 0 {
-		values := url.Values{}
-		for k, v := range params {
-			values.Add(k, v)
-		}
-		endpoint = fmt.Sprintf("%s?%s", endpoint, values.Encode())
-	}
-
-	// Create request
-	req, err := c.newRequest("GET", endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Log request for debugging
-	logging.LogDebug("Request URL: %s", req.URL.String())
-	logging.LogDebug("Request Headers: %v", req.Header)
-
-	// Send request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Read response body for logging
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	// Log response for debugging
-	logging.LogDebug("Response Status: %s", resp.Status)
-	logging.LogDebug("Request Headers: %v", req.Header)
-	if logging.IsDebugEnabled() {
-		var jsonData interface{}
-		if err := json.Unmarshal(body, &jsonData); err == nil {
-			logging.LogJSONInline("Response Body", jsonData)
-		} else {
-			logging.LogDebug("Response Body: %s", string(body))
-		}
-	}
-
-	// Check response status
-	if resp.StatusCode == http.StatusNotFound {
-		// Return empty response for 404 errors
-		logging.LogDebug("Confluence API - No comments found for page %s", pageID)
-		return &atlassian.AtlassianConfluenceFooterCommentsResponse{
-			Results: []atlassian.AtlassianConfluenceFooterComment{},
-		}, nil
-	}
-	if resp.StatusCode != http.StatusOK {
-		var errorResp atlassian.AtlassianConfluenceError
-		if err := json.Unmarshal(body, &errorResp); err == nil {
-			errorResp.StatusCode = resp.StatusCode
-			logging.LogDebug("Confluence API Error - Status Code: %d, Error: %+v", resp.StatusCode, errorResp)
-			return nil, &errorResp
-		}
-		logging.LogDebug("Confluence API Error - Status Code: %d, Body: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
-	}
-
-	// Parse response
-	var result atlassian.AtlassianConfluenceFooterCommentsResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		logging.LogDebug("Failed to decode response: %s", string(body))
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &result, nil
-}
@@ -0,0 +1,145 @@
+package atlassian
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceGetPageAttachments retrieves every attachment of a page
+func (c *Client) AtlassianConfluenceGetPageAttachments(pageID string) ([]atlassian.AtlassianConfluenceAttachment, error) {
+	params := url.Values{}
+	params.Add("limit", "100")
+
+	endpoint := fmt.Sprintf("/wiki/api/v2/pages/%s/attachments?%s", pageID, params.Encode())
+
+	var attachments []atlassian.AtlassianConfluenceAttachment
+	for endpoint != "" {
+		req, err := c.newRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluenceAttachmentsResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, result.Results...)
+
+		// Follow the cursor link until the last page
+		endpoint = result.Links.Next
+	}
+
+	return attachments, nil
+}
+
+// AtlassianConfluenceDownloadAttachment opens the content of an attachment for streaming.
+// The caller must close the returned reader. The size is -1 when the server does not report it.
+func (c *Client) AtlassianConfluenceDownloadAttachment(attachment atlassian.AtlassianConfluenceAttachment) (io.ReadCloser, int64, error) {
+	if attachment.DownloadLink == "" {
+		return nil, 0, fmt.Errorf("attachment %s has no download link", attachment.ID)
+	}
+
+	req, err := c.newRequest("GET", "/wiki"+attachment.DownloadLink, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "*/*")
+
+	resp, err := c.doStreamRequest(req, newConfluenceError)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	size := resp.ContentLength
+	if size < 0 && attachment.FileSize > 0 {
+		size = attachment.FileSize
+	}
+	return resp.Body, size, nil
+}
+
+// atlassianConfluenceAttachmentContent represents an attachment as returned by the v1 content API
+type atlassianConfluenceAttachmentContent struct {
+	ID         string                               `json:"id"`
+	Title      string                               `json:"title"`
+	Version    atlassian.AtlassianConfluenceVersion `json:"version"`
+	Extensions struct {
+		MediaType string `json:"mediaType"`
+		FileSize  int64  `json:"fileSize"`
+		Comment   string `json:"comment"`
+	} `json:"extensions"`
+	Links struct {
+		Download string `json:"download"`
+		WebUI    string `json:"webui"`
+	} `json:"_links"`
+}
+
+// AtlassianConfluenceUploadAttachment uploads a file to a page. When the page already has an
+// attachment with the same name, the file is uploaded as a new version of that attachment.
+func (c *Client) AtlassianConfluenceUploadAttachment(pageID, fileName string, content io.Reader, comment string, minorEdit bool) (*atlassian.AtlassianConfluenceAttachment, error) {
+	existing, err := c.AtlassianConfluenceGetPageAttachments(pageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing attachments: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/wiki/rest/api/content/%s/child/attachment", pageID)
+	isUpdate := false
+	for _, attachment := range existing {
+		if attachment.Title == fileName {
+			endpoint = fmt.Sprintf("%s/%s/data", endpoint, attachment.ID)
+			isUpdate = true
+			break
+		}
+	}
+
+	fields := map[string]string{
+		"minorEdit": strconv.FormatBool(minorEdit),
+	}
+	if comment != "" {
+		fields["comment"] = comment
+	}
+
+	req, err := c.newRequest("POST", endpoint, newMultipartBody("file", fileName, content, fields))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	body, err := c.doRequest(req, newConfluenceError)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creating answers with a list of attachments, updating with the attachment itself
+	var uploaded atlassianConfluenceAttachmentContent
+	if !isUpdate {
+		var result struct {
+			Results []atlassianConfluenceAttachmentContent `json:"results"`
+		}
+		if err := decodeResponse(body, &result); err != nil {
+			return nil, err
+		}
+		if len(result.Results) == 0 {
+			return nil, fmt.Errorf("upload of %s returned no attachment", fileName)
+		}
+		uploaded = result.Results[0]
+	} else if err := decodeResponse(body, &uploaded); err != nil {
+		return nil, err
+	}
+
+	return &atlassian.AtlassianConfluenceAttachment{
+		ID:           uploaded.ID,
+		Status:       "current",
+		Title:        uploaded.Title,
+		PageID:       pageID,
+		MediaType:    uploaded.Extensions.MediaType,
+		Comment:      uploaded.Extensions.Comment,
+		FileSize:     uploaded.Extensions.FileSize,
+		WebUILink:    uploaded.Links.WebUI,
+		DownloadLink: uploaded.Links.Download,
+		Version:      uploaded.Version,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceListBlogPosts lists the blog posts of a space, newest first.
+// A limit of zero or less lists all blog posts.
+func (c *Client) AtlassianConfluenceListBlogPosts(spaceID string, limit int) ([]atlassian.AtlassianConfluencePageDetails, error) {
+	params := url.Values{}
+	params.Add("sort", "-created-date")
+	params.Add("limit", "100")
+
+	endpoint := fmt.Sprintf("/wiki/api/v2/spaces/%s/blogposts?%s", spaceID, params.Encode())
+
+	var posts []atlassian.AtlassianConfluencePageDetails
+	for endpoint != "" {
+		req, err := c.newRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluenceBlogPostsResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		for _, post := range result.Results {
+			post.Type = "blogpost"
+			posts = append(posts, post)
+			if limit > 0 && len(posts) == limit {
+				return posts, nil
+			}
+		}
+
+		// Follow the cursor link until the last page
+		endpoint = result.Links.Next
+	}
+
+	return posts, nil
+}
+
+// AtlassianConfluenceGetBlogPost gets a blog post with its body in Atlassian Document Format
+func (c *Client) AtlassianConfluenceGetBlogPost(blogPostID string) (*atlassian.AtlassianConfluencePageDetails, error) {
+	params := url.Values{}
+	params.Add("body-format", "atlas_doc_format")
+
+	req, err := c.newRequest("GET", fmt.Sprintf("/wiki/api/v2/blogposts/%s?%s", blogPostID, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var post atlassian.AtlassianConfluencePageDetails
+	if err := c.doConfluenceRequest(req, &post); err != nil {
+		return nil, err
+	}
+	post.Type = "blogpost"
+
+	return &post, nil
+}
+
+// AtlassianConfluenceCreateBlogPost creates a blog post and returns it. The parent in opts is ignored.
+func (c *Client) AtlassianConfluenceCreateBlogPost(opts atlassian.AtlassianConfluenceCreatePageOptions) (*atlassian.AtlassianConfluencePageDetails, error) {
+	status := opts.Status
+	if status == "" {
+		status = "current"
+	}
+	representation := opts.Representation
+	if representation == "" {
+		representation = "atlas_doc_format"
+	}
+
+	body := map[string]interface{}{
+		"spaceId": opts.SpaceID,
+		"status":  status,
+		"title":   opts.Title,
+		"body": atlassian.AtlassianConfluenceCommentBody{
+			Representation: representation,
+			Value:          opts.Body,
+		},
+	}
+
+	req, err := c.newRequest("POST", "/wiki/api/v2/blogposts", body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var post atlassian.AtlassianConfluencePageDetails
+	if err := c.doConfluenceRequest(req, &post); err != nil {
+		return nil, err
+	}
+	post.Type = "blogpost"
+
+	return &post, nil
+}
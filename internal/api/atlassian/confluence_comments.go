@@ -0,0 +1,206 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"markcli/internal/logging"
+	"markcli/internal/types/atlassian"
+)
+
+const (
+	// maxCommentReplyDepth limits how deep reply threads are followed
+	maxCommentReplyDepth = 5
+	// maxCommentReplyRequests caps the reply lookups made for the footer or the inline comments of a page
+	maxCommentReplyRequests = 200
+)
+
+// AtlassianConfluenceGetPageComments retrieves every footer and inline comment on a page,
+// following pagination and including nested replies. Comments whose replies were not
+// fetched because a limit was reached are marked with RepliesTruncated.
+func (c *Client) AtlassianConfluenceGetPageComments(pageID string) (footer, inline []atlassian.AtlassianConfluenceComment, err error) {
+	// Footer and inline comments get a budget each, so one kind cannot starve the other
+	footerBudget := maxCommentReplyRequests
+	footer, err = c.atlassianConfluenceListComments(fmt.Sprintf("/wiki/api/v2/pages/%s/footer-comments", pageID), false, 0, &footerBudget)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get footer comments: %w", err)
+	}
+
+	inlineBudget := maxCommentReplyRequests
+	inline, err = c.atlassianConfluenceListComments(fmt.Sprintf("/wiki/api/v2/pages/%s/inline-comments", pageID), true, 0, &inlineBudget)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get inline comments: %w", err)
+	}
+
+	return footer, inline, nil
+}
+
+// atlassianConfluenceListComments fetches all comments from a v2 comments endpoint,
+// then recursively fetches the replies of each comment. Replies are followed up to
+// maxCommentReplyDepth levels deep and while the request budget lasts.
+func (c *Client) atlassianConfluenceListComments(path string, inline bool, depth int, budget *int) ([]atlassian.AtlassianConfluenceComment, error) {
+	params := url.Values{}
+	params.Add("body-format", "atlas_doc_format")
+	params.Add("limit", "100")
+
+	endpoint := fmt.Sprintf("%s?%s", path, params.Encode())
+
+	var comments []atlassian.AtlassianConfluenceComment
+	for endpoint != "" {
+		req, err := c.newRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluenceCommentsResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			// Pages without comments may answer with 404
+			if apiErr, ok := err.(*atlassian.AtlassianConfluenceError); ok && apiErr.StatusCode == http.StatusNotFound {
+				break
+			}
+			return nil, err
+		}
+
+		comments = append(comments, result.Results...)
+
+		// Follow the cursor link until the last page
+		endpoint = result.Links.Next
+	}
+
+	for i := range comments {
+		comment := &comments[i]
+		comment.Inline = inline
+		comment.Version.Author.AccountID = comment.Version.AuthorID
+		comment.Version.Author.DisplayName = c.AtlassianConfluenceUserDisplayName(comment.Version.AuthorID)
+
+		if depth >= maxCommentReplyDepth || *budget <= 0 {
+			logging.LogDebug("Not following replies to comment %s: depth or reply request limit reached", comment.ID)
+			comment.RepliesTruncated = true
+			continue
+		}
+		*budget--
+
+		replies, err := c.atlassianConfluenceListComments(fmt.Sprintf("%s/children", commentPath(comment.ID, inline)), inline, depth+1, budget)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get replies to comment %s: %w", comment.ID, err)
+		}
+		comment.Replies = replies
+	}
+
+	return comments, nil
+}
+
+// AtlassianConfluenceGetComment gets a single comment by ID.
+// Footer comments are tried first, then inline comments.
+func (c *Client) AtlassianConfluenceGetComment(commentID string) (*atlassian.AtlassianConfluenceComment, error) {
+	params := url.Values{}
+	params.Add("body-format", "atlas_doc_format")
+
+	var lastErr error
+	for _, inline := range []bool{false, true} {
+		req, err := c.newRequest("GET", fmt.Sprintf("%s?%s", commentPath(commentID, inline), params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var comment atlassian.AtlassianConfluenceComment
+		err = c.doConfluenceRequest(req, &comment)
+		if err == nil {
+			comment.Inline = inline
+			return &comment, nil
+		}
+		if apiErr, ok := err.(*atlassian.AtlassianConfluenceError); !ok || apiErr.StatusCode != http.StatusNotFound {
+			return nil, err
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// AtlassianConfluenceCreateComment creates a footer or inline comment, or a reply to one
+func (c *Client) AtlassianConfluenceCreateComment(opts atlassian.AtlassianConfluenceCreateCommentOptions) (*atlassian.AtlassianConfluenceComment, error) {
+	body := map[string]interface{}{
+		"body": atlassian.AtlassianConfluenceCommentBody{
+			Representation: "atlas_doc_format",
+			Value:          opts.Body,
+		},
+	}
+	if opts.ParentCommentID != "" {
+		body["parentCommentId"] = opts.ParentCommentID
+	} else {
+		body["pageId"] = opts.PageID
+	}
+
+	// Only top-level inline comments are anchored to a text selection
+	if opts.Inline && opts.ParentCommentID == "" {
+		body["inlineCommentProperties"] = map[string]interface{}{
+			"textSelection":           opts.TextSelection,
+			"textSelectionMatchCount": opts.MatchCount,
+			"textSelectionMatchIndex": opts.MatchIndex,
+		}
+	}
+
+	endpoint := "/wiki/api/v2/footer-comments"
+	if opts.Inline {
+		endpoint = "/wiki/api/v2/inline-comments"
+	}
+
+	req, err := c.newRequest("POST", endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var comment atlassian.AtlassianConfluenceComment
+	if err := c.doConfluenceRequest(req, &comment); err != nil {
+		return nil, err
+	}
+	comment.Inline = opts.Inline
+
+	return &comment, nil
+}
+
+// AtlassianConfluenceResolveInlineComment resolves or reopens an inline comment
+func (c *Client) AtlassianConfluenceResolveInlineComment(commentID string, resolved bool) (*atlassian.AtlassianConfluenceComment, error) {
+	comment, err := c.AtlassianConfluenceGetComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if !comment.Inline {
+		return nil, fmt.Errorf("comment %s is a footer comment; only inline comments can be resolved", commentID)
+	}
+
+	// Updates must carry the body and the next version number
+	body := map[string]interface{}{
+		"version": map[string]interface{}{
+			"number": comment.Version.Number + 1,
+		},
+		"body": atlassian.AtlassianConfluenceCommentBody{
+			Representation: "atlas_doc_format",
+			Value:          comment.Body.AtlasDocFormat.Value,
+		},
+		"resolved": resolved,
+	}
+
+	req, err := c.newRequest("PUT", commentPath(commentID, true), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result atlassian.AtlassianConfluenceComment
+	if err := c.doConfluenceRequest(req, &result); err != nil {
+		return nil, err
+	}
+	result.Inline = true
+
+	return &result, nil
+}
+
+// commentPath returns the v2 API path of a footer or inline comment
+func commentPath(commentID string, inline bool) string {
+	if inline {
+		return fmt.Sprintf("/wiki/api/v2/inline-comments/%s", commentID)
+	}
+	return fmt.Sprintf("/wiki/api/v2/footer-comments/%s", commentID)
+}
@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceGetPageVersions returns the version history of a page, newest first.
+// A limit of zero or less returns every version.
+func (c *Client) AtlassianConfluenceGetPageVersions(pageID string, limit int) ([]atlassian.AtlassianConfluencePageVersion, error) {
+	params := url.Values{}
+	params.Add("sort", "-modified-date")
+	params.Add("limit", "50")
+
+	endpoint := fmt.Sprintf("/wiki/api/v2/pages/%s/versions?%s", pageID, params.Encode())
+
+	var versions []atlassian.AtlassianConfluencePageVersion
+	for endpoint != "" {
+		req, err := c.newRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluencePageVersionsResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, result.Results...)
+		if limit > 0 && len(versions) >= limit {
+			return versions[:limit], nil
+		}
+
+		// Follow the cursor link until the last page
+		endpoint = result.Links.Next
+	}
+
+	return versions, nil
+}
+
+// AtlassianConfluenceGetPageAtVersion gets a page with its body as it was at the given version.
+// A version of zero or less returns the current version.
+func (c *Client) AtlassianConfluenceGetPageAtVersion(pageID string, version int) (*atlassian.AtlassianConfluencePageDetails, error) {
+	params := url.Values{}
+	params.Add("body-format", "atlas_doc_format")
+	if version > 0 {
+		params.Add("version", fmt.Sprintf("%d", version))
+	}
+
+	req, err := c.newRequest("GET", fmt.Sprintf("/wiki/api/v2/pages/%s?%s", pageID, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result atlassian.AtlassianConfluencePageDetails
+	if err := c.doConfluenceRequest(req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,82 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceGetPageLabels returns every label attached to a page
+func (c *Client) AtlassianConfluenceGetPageLabels(pageID string) ([]atlassian.AtlassianConfluenceLabel, error) {
+	return c.atlassianConfluenceListLabels(fmt.Sprintf("/wiki/api/v2/pages/%s/labels", pageID))
+}
+
+// AtlassianConfluenceGetBlogPostLabels returns every label attached to a blog post
+func (c *Client) AtlassianConfluenceGetBlogPostLabels(blogPostID string) ([]atlassian.AtlassianConfluenceLabel, error) {
+	return c.atlassianConfluenceListLabels(fmt.Sprintf("/wiki/api/v2/blogposts/%s/labels", blogPostID))
+}
+
+// atlassianConfluenceListLabels fetches all labels from a v2 labels endpoint
+func (c *Client) atlassianConfluenceListLabels(path string) ([]atlassian.AtlassianConfluenceLabel, error) {
+	params := url.Values{}
+	params.Add("limit", "250")
+
+	endpoint := fmt.Sprintf("%s?%s", path, params.Encode())
+
+	var labels []atlassian.AtlassianConfluenceLabel
+	for endpoint != "" {
+		req, err := c.newRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluenceLabelsResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, result.Results...)
+
+		// Follow the cursor link until the last page
+		endpoint = result.Links.Next
+	}
+
+	return labels, nil
+}
+
+// AtlassianConfluenceAddLabels adds global labels to a page and returns the resulting labels
+func (c *Client) AtlassianConfluenceAddLabels(pageID string, names []string) ([]atlassian.AtlassianConfluenceLabel, error) {
+	body := make([]map[string]string, 0, len(names))
+	for _, name := range names {
+		body = append(body, map[string]string{
+			"prefix": "global",
+			"name":   name,
+		})
+	}
+
+	req, err := c.newRequest("POST", fmt.Sprintf("/wiki/rest/api/content/%s/label", pageID), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result atlassian.AtlassianConfluenceLabelsResponse
+	if err := c.doConfluenceRequest(req, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Results, nil
+}
+
+// AtlassianConfluenceRemoveLabel removes a label from a page
+func (c *Client) AtlassianConfluenceRemoveLabel(pageID, name string) error {
+	params := url.Values{}
+	params.Add("name", name)
+
+	req, err := c.newRequest("DELETE", fmt.Sprintf("/wiki/rest/api/content/%s/label?%s", pageID, params.Encode()), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doConfluenceRequest(req, nil)
+}
@@ -0,0 +1,210 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"markcli/internal/types/atlassian"
+)
+
+const (
+	// longTaskPollInterval is how often the status of an asynchronous operation is checked
+	longTaskPollInterval = time.Second
+	// longTaskTimeout is how long an asynchronous operation is waited for before giving up
+	longTaskTimeout = 10 * time.Minute
+)
+
+// AtlassianConfluenceMovePage moves a page, with its descendants, to become the last child of parentID.
+// The parent may be in another space.
+func (c *Client) AtlassianConfluenceMovePage(pageID, parentID string) error {
+	req, err := c.newRequest("PUT", fmt.Sprintf("/wiki/rest/api/content/%s/move/append/%s", pageID, parentID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doConfluenceRequest(req, nil)
+}
+
+// AtlassianConfluenceCopyPage copies a page under a new parent, preserving its labels and attachments.
+// Recursive copies run as an asynchronous task, which is waited for. The ID of the copy is returned
+// for single page copies; recursive copies return an empty ID.
+func (c *Client) AtlassianConfluenceCopyPage(opts atlassian.AtlassianConfluenceCopyPageOptions) (string, error) {
+	if opts.Recursive {
+		body := map[string]interface{}{
+			"copyAttachments":    true,
+			"copyLabels":         true,
+			"copyPermissions":    true,
+			"copyProperties":     true,
+			"copyCustomContents": true,
+			"copyDescendants":    true,
+			"originalPageId":     opts.PageID,
+			"destinationPageId":  opts.ParentID,
+		}
+		if opts.TitlePrefix != "" {
+			body["titleOptions"] = map[string]string{"prefix": opts.TitlePrefix}
+		}
+
+		req, err := c.newRequest("POST", fmt.Sprintf("/wiki/rest/api/content/%s/pagehierarchy/copy", opts.PageID), body)
+		if err != nil {
+			return "", fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var task atlassian.AtlassianConfluenceLongTask
+		if err := c.doConfluenceRequest(req, &task); err != nil {
+			return "", err
+		}
+
+		_, err = c.AtlassianConfluenceWaitForLongTask(task.ID)
+		return "", err
+	}
+
+	body := map[string]interface{}{
+		"copyAttachments":    true,
+		"copyLabels":         true,
+		"copyPermissions":    true,
+		"copyProperties":     true,
+		"copyCustomContents": true,
+		"destination": map[string]string{
+			"type":  "parent_page",
+			"value": opts.ParentID,
+		},
+	}
+	if opts.Title != "" {
+		body["pageTitle"] = opts.Title
+	}
+
+	req, err := c.newRequest("POST", fmt.Sprintf("/wiki/rest/api/content/%s/copy", opts.PageID), body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var copied struct {
+		ID string `json:"id"`
+	}
+	if err := c.doConfluenceRequest(req, &copied); err != nil {
+		return "", err
+	}
+
+	return copied.ID, nil
+}
+
+// AtlassianConfluenceArchivePages archives the given pages and waits for the archive task to finish
+func (c *Client) AtlassianConfluenceArchivePages(pageIDs []string) error {
+	pages := make([]map[string]string, len(pageIDs))
+	for i, id := range pageIDs {
+		pages[i] = map[string]string{"id": id}
+	}
+
+	req, err := c.newRequest("POST", "/wiki/rest/api/content/archive", map[string]interface{}{"pages": pages})
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var task atlassian.AtlassianConfluenceLongTask
+	if err := c.doConfluenceRequest(req, &task); err != nil {
+		return err
+	}
+
+	_, err = c.AtlassianConfluenceWaitForLongTask(task.ID)
+	return err
+}
+
+// AtlassianConfluenceDeletePage moves a page to the trash. With purge, the page is then
+// removed from the trash permanently.
+func (c *Client) AtlassianConfluenceDeletePage(pageID string, purge bool) error {
+	req, err := c.newRequest("DELETE", fmt.Sprintf("/wiki/api/v2/pages/%s", pageID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if err := c.doConfluenceRequest(req, nil); err != nil {
+		return err
+	}
+
+	if !purge {
+		return nil
+	}
+
+	// Only trashed pages can be purged
+	params := url.Values{}
+	params.Add("purge", "true")
+	req, err = c.newRequest("DELETE", fmt.Sprintf("/wiki/api/v2/pages/%s?%s", pageID, params.Encode()), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doConfluenceRequest(req, nil)
+}
+
+// AtlassianConfluenceWaitForLongTask polls an asynchronous task until it finishes.
+// An error is returned when the task does not succeed or does not finish within longTaskTimeout.
+func (c *Client) AtlassianConfluenceWaitForLongTask(taskID string) (*atlassian.AtlassianConfluenceLongTask, error) {
+	deadline := time.Now().Add(longTaskTimeout)
+	for {
+		req, err := c.newRequest("GET", fmt.Sprintf("/wiki/rest/api/longtask/%s", taskID), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var task atlassian.AtlassianConfluenceLongTask
+		if err := c.doConfluenceRequest(req, &task); err != nil {
+			return nil, err
+		}
+
+		if task.Finished {
+			if !task.Successful {
+				var messages []string
+				for _, message := range task.Messages {
+					messages = append(messages, message.Translation)
+				}
+				return &task, fmt.Errorf("task %s failed: %s", taskID, strings.Join(messages, "; "))
+			}
+			return &task, nil
+		}
+
+		// The task keeps running on the server; report where it got to
+		if time.Now().After(deadline) {
+			return &task, fmt.Errorf("task %s did not finish within %s (%d%% complete); it may still complete on the server", taskID, longTaskTimeout, task.PercentageComplete)
+		}
+
+		time.Sleep(longTaskPollInterval)
+	}
+}
+
+// AtlassianConfluenceCreatePage creates a page and returns it
+func (c *Client) AtlassianConfluenceCreatePage(opts atlassian.AtlassianConfluenceCreatePageOptions) (*atlassian.AtlassianConfluencePageDetails, error) {
+	status := opts.Status
+	if status == "" {
+		status = "current"
+	}
+	representation := opts.Representation
+	if representation == "" {
+		representation = "atlas_doc_format"
+	}
+
+	body := map[string]interface{}{
+		"spaceId": opts.SpaceID,
+		"status":  status,
+		"title":   opts.Title,
+		"body": atlassian.AtlassianConfluenceCommentBody{
+			Representation: representation,
+			Value:          opts.Body,
+		},
+	}
+	if opts.ParentID != "" {
+		body["parentId"] = opts.ParentID
+	}
+
+	req, err := c.newRequest("POST", "/wiki/api/v2/pages", body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var page atlassian.AtlassianConfluencePageDetails
+	if err := c.doConfluenceRequest(req, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
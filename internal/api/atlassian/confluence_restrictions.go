@@ -0,0 +1,132 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceGetPageRestrictions returns the read and update restrictions set directly on a page
+func (c *Client) AtlassianConfluenceGetPageRestrictions(pageID string) (read, update atlassian.AtlassianConfluenceRestriction, err error) {
+	params := url.Values{}
+	params.Add("expand", "restrictions.user,restrictions.group")
+
+	req, err := c.newRequest("GET", fmt.Sprintf("/wiki/rest/api/content/%s/restriction/byOperation?%s", pageID, params.Encode()), nil)
+	if err != nil {
+		return read, update, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result map[string]atlassian.AtlassianConfluenceRestriction
+	if err := c.doConfluenceRequest(req, &result); err != nil {
+		return read, update, err
+	}
+
+	return result["read"], result["update"], nil
+}
+
+// AtlassianConfluenceAddRestriction restricts an operation on a page to a user or group.
+// Principal type is "user" with an account ID, or "group" with a group ID.
+func (c *Client) AtlassianConfluenceAddRestriction(pageID, operation, principalType, principalID string) error {
+	req, err := c.newRequest("PUT", restrictionPath(pageID, operation, principalType, principalID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doConfluenceRequest(req, nil)
+}
+
+// AtlassianConfluenceRemoveRestriction removes a user or group from the restriction of an operation on a page
+func (c *Client) AtlassianConfluenceRemoveRestriction(pageID, operation, principalType, principalID string) error {
+	req, err := c.newRequest("DELETE", restrictionPath(pageID, operation, principalType, principalID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doConfluenceRequest(req, nil)
+}
+
+// restrictionPath returns the v1 API path of a user or group in the restriction of an operation
+func restrictionPath(pageID, operation, principalType, principalID string) string {
+	base := fmt.Sprintf("/wiki/rest/api/content/%s/restriction/byOperation/%s", pageID, operation)
+	if principalType == "group" {
+		return fmt.Sprintf("%s/byGroupId/%s", base, url.PathEscape(principalID))
+	}
+	return fmt.Sprintf("%s/user?accountId=%s", base, url.QueryEscape(principalID))
+}
+
+// AtlassianConfluenceCheckPermission asks Confluence whether a user may perform an operation on a page,
+// taking space permissions and restrictions into account
+func (c *Client) AtlassianConfluenceCheckPermission(pageID, accountID, operation string) (bool, error) {
+	body := map[string]interface{}{
+		"subject": map[string]string{
+			"type":       "user",
+			"identifier": accountID,
+		},
+		"operation": operation,
+	}
+
+	req, err := c.newRequest("POST", fmt.Sprintf("/wiki/rest/api/content/%s/permission/check", pageID), body)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result struct {
+		HasPermission bool `json:"hasPermission"`
+	}
+	if err := c.doConfluenceRequest(req, &result); err != nil {
+		return false, err
+	}
+
+	return result.HasPermission, nil
+}
+
+// AtlassianConfluenceGetGroupByName gets a user group by its name
+func (c *Client) AtlassianConfluenceGetGroupByName(name string) (*atlassian.AtlassianConfluenceGroup, error) {
+	params := url.Values{}
+	params.Add("name", name)
+
+	req, err := c.newRequest("GET", "/wiki/rest/api/group/by-name?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var group atlassian.AtlassianConfluenceGroup
+	if err := c.doConfluenceRequest(req, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// AtlassianConfluenceGetUserGroups returns every group a user is a member of
+func (c *Client) AtlassianConfluenceGetUserGroups(accountID string) ([]atlassian.AtlassianConfluenceGroup, error) {
+	const pageSize = 200
+
+	var groups []atlassian.AtlassianConfluenceGroup
+	for start := 0; ; start += pageSize {
+		params := url.Values{}
+		params.Add("accountId", accountID)
+		params.Add("start", fmt.Sprintf("%d", start))
+		params.Add("limit", fmt.Sprintf("%d", pageSize))
+
+		req, err := c.newRequest("GET", "/wiki/rest/api/user/memberof?"+params.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			Results []atlassian.AtlassianConfluenceGroup `json:"results"`
+		}
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, result.Results...)
+		if len(result.Results) < pageSize {
+			break
+		}
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,151 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceGetSpaceByID gets a space by its v2 ID
+func (c *Client) AtlassianConfluenceGetSpaceByID(spaceID string) (*atlassian.AtlassianConfluenceSpaceDetails, error) {
+	params := url.Values{}
+	params.Add("description-format", "plain")
+
+	req, err := c.newRequest("GET", fmt.Sprintf("/wiki/api/v2/spaces/%s?%s", spaceID, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var space atlassian.AtlassianConfluenceSpaceDetails
+	if err := c.doConfluenceRequest(req, &space); err != nil {
+		return nil, err
+	}
+
+	return &space, nil
+}
+
+// AtlassianConfluenceGetSpaceByKey gets a space by its key
+func (c *Client) AtlassianConfluenceGetSpaceByKey(spaceKey string) (*atlassian.AtlassianConfluenceSpaceDetails, error) {
+	params := url.Values{}
+	params.Add("keys", spaceKey)
+	params.Add("description-format", "plain")
+
+	req, err := c.newRequest("GET", "/wiki/api/v2/spaces?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result atlassian.AtlassianConfluenceSpacesResponse
+	if err := c.doConfluenceRequest(req, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		return nil, fmt.Errorf("space not found: %s", spaceKey)
+	}
+
+	return &result.Results[0], nil
+}
+
+// AtlassianConfluenceGetSpaceLabels returns the labels attached to the space itself
+func (c *Client) AtlassianConfluenceGetSpaceLabels(spaceID string) ([]atlassian.AtlassianConfluenceLabel, error) {
+	return c.atlassianConfluenceListLabels(fmt.Sprintf("/wiki/api/v2/spaces/%s/labels", spaceID))
+}
+
+// AtlassianConfluenceGetSpaceContentLabels returns the labels used on content within a space
+func (c *Client) AtlassianConfluenceGetSpaceContentLabels(spaceID string) ([]atlassian.AtlassianConfluenceLabel, error) {
+	return c.atlassianConfluenceListLabels(fmt.Sprintf("/wiki/api/v2/spaces/%s/content/labels", spaceID))
+}
+
+// AtlassianConfluenceGetSpacePermissions returns every permission granted in a space
+func (c *Client) AtlassianConfluenceGetSpacePermissions(spaceID string) ([]atlassian.AtlassianConfluenceSpacePermission, error) {
+	params := url.Values{}
+	params.Add("limit", "250")
+
+	endpoint := fmt.Sprintf("/wiki/api/v2/spaces/%s/permissions?%s", spaceID, params.Encode())
+
+	var permissions []atlassian.AtlassianConfluenceSpacePermission
+	for endpoint != "" {
+		req, err := c.newRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluenceSpacePermissionsResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		permissions = append(permissions, result.Results...)
+
+		// Follow the cursor link until the last page
+		endpoint = result.Links.Next
+	}
+
+	return permissions, nil
+}
+
+// AtlassianConfluenceGetGroup gets a user group by its ID
+func (c *Client) AtlassianConfluenceGetGroup(groupID string) (*atlassian.AtlassianConfluenceGroup, error) {
+	params := url.Values{}
+	params.Add("id", groupID)
+
+	req, err := c.newRequest("GET", "/wiki/rest/api/group/by-id?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var group atlassian.AtlassianConfluenceGroup
+	if err := c.doConfluenceRequest(req, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// AtlassianConfluenceCreateSpace creates a space and returns it as seen by the v2 API
+func (c *Client) AtlassianConfluenceCreateSpace(opts atlassian.AtlassianConfluenceCreateSpaceOptions) (*atlassian.AtlassianConfluenceSpaceDetails, error) {
+	body := map[string]interface{}{
+		"key":  opts.Key,
+		"name": opts.Name,
+	}
+	if opts.Description != "" {
+		body["description"] = map[string]interface{}{
+			"plain": map[string]string{
+				"value":          opts.Description,
+				"representation": "plain",
+			},
+		}
+	}
+
+	endpoint := "/wiki/rest/api/space"
+	if opts.Private {
+		endpoint = "/wiki/rest/api/space/_private"
+	}
+
+	req, err := c.newRequest("POST", endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var created atlassian.AtlassianConfluenceSpace
+	if err := c.doConfluenceRequest(req, &created); err != nil {
+		return nil, err
+	}
+
+	return c.AtlassianConfluenceGetSpaceByKey(created.Key)
+}
+
+// AtlassianConfluenceSetSpaceStatus archives a space, or restores it with status "current"
+func (c *Client) AtlassianConfluenceSetSpaceStatus(spaceKey, status string) error {
+	body := map[string]string{
+		"status": status,
+	}
+
+	req, err := c.newRequest("PUT", fmt.Sprintf("/wiki/rest/api/space/%s", url.PathEscape(spaceKey)), body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doConfluenceRequest(req, nil)
+}
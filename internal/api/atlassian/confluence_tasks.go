@@ -0,0 +1,92 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceListTasks lists inline tasks across pages and blog posts, following pagination
+func (c *Client) AtlassianConfluenceListTasks(opts atlassian.AtlassianConfluenceTaskOptions) ([]atlassian.AtlassianConfluenceTask, error) {
+	params := url.Values{}
+	params.Add("body-format", "atlas_doc_format")
+	params.Add("limit", "250")
+	if opts.Status != "" {
+		params.Add("status", opts.Status)
+	}
+	if opts.AssigneeID != "" {
+		params.Add("assigned-to", opts.AssigneeID)
+	}
+	if opts.SpaceID != "" {
+		params.Add("space-id", opts.SpaceID)
+	}
+	if !opts.DueBefore.IsZero() {
+		params.Add("due-at-to", fmt.Sprintf("%d", opts.DueBefore.UnixMilli()))
+	}
+
+	endpoint := "/wiki/api/v2/tasks?" + params.Encode()
+
+	var tasks []atlassian.AtlassianConfluenceTask
+	for endpoint != "" {
+		req, err := c.newRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluenceTasksResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, result.Results...)
+		if opts.Limit > 0 && len(tasks) >= opts.Limit {
+			return tasks[:opts.Limit], nil
+		}
+
+		// Follow the cursor link until the last page
+		endpoint = result.Links.Next
+	}
+
+	return tasks, nil
+}
+
+// AtlassianConfluenceGetTask gets a single task by ID
+func (c *Client) AtlassianConfluenceGetTask(taskID string) (*atlassian.AtlassianConfluenceTask, error) {
+	params := url.Values{}
+	params.Add("body-format", "atlas_doc_format")
+
+	req, err := c.newRequest("GET", fmt.Sprintf("/wiki/api/v2/tasks/%s?%s", taskID, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var task atlassian.AtlassianConfluenceTask
+	if err := c.doConfluenceRequest(req, &task); err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// AtlassianConfluenceSetTaskStatus marks a task as "complete" or "incomplete"
+func (c *Client) AtlassianConfluenceSetTaskStatus(taskID, status string) (*atlassian.AtlassianConfluenceTask, error) {
+	params := url.Values{}
+	params.Add("body-format", "atlas_doc_format")
+
+	body := map[string]string{
+		"status": status,
+	}
+
+	req, err := c.newRequest("PUT", fmt.Sprintf("/wiki/api/v2/tasks/%s?%s", taskID, params.Encode()), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var task atlassian.AtlassianConfluenceTask
+	if err := c.doConfluenceRequest(req, &task); err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
@@ -0,0 +1,76 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceListTemplates lists page templates and blueprints. With a space key,
+// the templates of that space are listed, otherwise the global ones.
+func (c *Client) AtlassianConfluenceListTemplates(spaceKey string) ([]atlassian.AtlassianConfluenceTemplate, error) {
+	var templates []atlassian.AtlassianConfluenceTemplate
+	for _, kind := range []string{"page", "blueprint"} {
+		results, err := c.atlassianConfluenceListTemplates(kind, spaceKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s templates: %w", kind, err)
+		}
+		templates = append(templates, results...)
+	}
+	return templates, nil
+}
+
+// atlassianConfluenceListTemplates fetches every template of one kind, following offset pagination
+func (c *Client) atlassianConfluenceListTemplates(kind, spaceKey string) ([]atlassian.AtlassianConfluenceTemplate, error) {
+	const limit = 100
+
+	var templates []atlassian.AtlassianConfluenceTemplate
+	for start := 0; ; start += limit {
+		params := url.Values{}
+		params.Add("start", fmt.Sprintf("%d", start))
+		params.Add("limit", fmt.Sprintf("%d", limit))
+		if spaceKey != "" {
+			params.Add("spaceKey", spaceKey)
+		}
+
+		req, err := c.newRequest("GET", fmt.Sprintf("/wiki/rest/api/template/%s?%s", kind, params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluenceTemplatesResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		for _, template := range result.Results {
+			if template.TemplateType == "" {
+				template.TemplateType = kind
+			}
+			templates = append(templates, template)
+		}
+
+		if result.Size < limit {
+			return templates, nil
+		}
+	}
+}
+
+// AtlassianConfluenceGetTemplate gets a template with its body, preferring Atlassian Document Format
+func (c *Client) AtlassianConfluenceGetTemplate(templateID string) (*atlassian.AtlassianConfluenceTemplate, error) {
+	params := url.Values{}
+	params.Add("expand", "body.atlas_doc_format,body.storage")
+
+	req, err := c.newRequest("GET", fmt.Sprintf("/wiki/rest/api/template/%s?%s", templateID, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var template atlassian.AtlassianConfluenceTemplate
+	if err := c.doConfluenceRequest(req, &template); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
@@ -0,0 +1,86 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceGetPageChildren retrieves the direct child pages of a page
+func (c *Client) AtlassianConfluenceGetPageChildren(pageID string) ([]atlassian.AtlassianConfluencePageNode, error) {
+	params := url.Values{}
+	params.Add("limit", "250")
+
+	return c.atlassianConfluenceListPageNodes(fmt.Sprintf("/wiki/api/v2/pages/%s/children?%s", pageID, params.Encode()))
+}
+
+// AtlassianConfluenceGetPageAncestors retrieves the ancestors of a page, starting at the top of the tree
+func (c *Client) AtlassianConfluenceGetPageAncestors(pageID string) ([]atlassian.AtlassianConfluencePageNode, error) {
+	params := url.Values{}
+	params.Add("limit", "250")
+
+	return c.atlassianConfluenceListPageNodes(fmt.Sprintf("/wiki/api/v2/pages/%s/ancestors?%s", pageID, params.Encode()))
+}
+
+// AtlassianConfluenceGetPageTree retrieves a page together with all of its descendants
+func (c *Client) AtlassianConfluenceGetPageTree(pageID string) (*atlassian.AtlassianConfluencePageNode, error) {
+	page, err := c.AtlassianConfluenceGetPage(pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	root := atlassian.AtlassianConfluencePageNode{
+		ID:       page.ID,
+		Title:    page.Title,
+		Status:   page.Status,
+		SpaceID:  page.SpaceId,
+		ParentID: page.ParentId,
+	}
+	if err := c.atlassianConfluenceFillPageTree(&root); err != nil {
+		return nil, err
+	}
+
+	return &root, nil
+}
+
+// atlassianConfluenceFillPageTree recursively fetches the children of a node
+func (c *Client) atlassianConfluenceFillPageTree(node *atlassian.AtlassianConfluencePageNode) error {
+	children, err := c.AtlassianConfluenceGetPageChildren(node.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get children of page %s: %w", node.ID, err)
+	}
+
+	for i := range children {
+		children[i].ParentID = node.ID
+		if err := c.atlassianConfluenceFillPageTree(&children[i]); err != nil {
+			return err
+		}
+	}
+	node.Children = children
+
+	return nil
+}
+
+// atlassianConfluenceListPageNodes fetches all pages from a v2 page listing endpoint
+func (c *Client) atlassianConfluenceListPageNodes(endpoint string) ([]atlassian.AtlassianConfluencePageNode, error) {
+	var nodes []atlassian.AtlassianConfluencePageNode
+	for endpoint != "" {
+		req, err := c.newRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianConfluencePageNodesResponse
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, result.Results...)
+
+		// Follow the cursor link until the last page
+		endpoint = result.Links.Next
+	}
+
+	return nodes, nil
+}
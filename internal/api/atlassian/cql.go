@@ -0,0 +1,163 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceContentTypes lists the content types that can be searched with CQL
+var AtlassianConfluenceContentTypes = []string{"page", "blogpost", "comment", "attachment", "whiteboard", "database", "folder", "embed"}
+
+// relativeCQLDate matches relative dates such as 30m, 12h, 7d, 2w, 3M or 1y.
+// As in CQL itself, lowercase m means minutes and uppercase M means months.
+var relativeCQLDate = regexp.MustCompile(`^(\d+)([mhdwMy])$`)
+
+// AtlassianConfluenceCQLQuote quotes a value as a CQL string literal, escaping
+// backslashes and double quotes so user input cannot alter the query
+func AtlassianConfluenceCQLQuote(value string) string {
+	escaped := strings.ReplaceAll(value, `\`, `\\`)
+	escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+	return `"` + escaped + `"`
+}
+
+// AtlassianConfluenceCQLBuilder composes a CQL query from clauses joined with AND
+type AtlassianConfluenceCQLBuilder struct {
+	clauses []string
+	orderBy string
+}
+
+// AtlassianConfluenceNewCQLBuilder creates an empty CQL builder
+func AtlassianConfluenceNewCQLBuilder() *AtlassianConfluenceCQLBuilder {
+	return &AtlassianConfluenceCQLBuilder{}
+}
+
+// Where adds a clause comparing field to a quoted value, e.g. Where("title", "~", "ADR")
+func (b *AtlassianConfluenceCQLBuilder) Where(field, operator, value string) *AtlassianConfluenceCQLBuilder {
+	b.clauses = append(b.clauses, fmt.Sprintf("%s %s %s", field, operator, AtlassianConfluenceCQLQuote(value)))
+	return b
+}
+
+// WhereIn adds a clause matching any of the quoted values
+func (b *AtlassianConfluenceCQLBuilder) WhereIn(field string, values []string) *AtlassianConfluenceCQLBuilder {
+	if len(values) == 1 {
+		return b.Where(field, "=", values[0])
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = AtlassianConfluenceCQLQuote(value)
+	}
+	b.clauses = append(b.clauses, fmt.Sprintf("%s in (%s)", field, strings.Join(quoted, ", ")))
+	return b
+}
+
+// Raw adds a clause verbatim. Only use it for values that are not user input, such as function calls.
+func (b *AtlassianConfluenceCQLBuilder) Raw(clause string) *AtlassianConfluenceCQLBuilder {
+	b.clauses = append(b.clauses, clause)
+	return b
+}
+
+// OrderBy sets the sort field and direction
+func (b *AtlassianConfluenceCQLBuilder) OrderBy(field, order string) *AtlassianConfluenceCQLBuilder {
+	b.orderBy = strings.TrimSpace(field + " " + order)
+	return b
+}
+
+// Empty reports whether no clauses have been added
+func (b *AtlassianConfluenceCQLBuilder) Empty() bool {
+	return len(b.clauses) == 0
+}
+
+// String returns the CQL query
+func (b *AtlassianConfluenceCQLBuilder) String() string {
+	cql := strings.Join(b.clauses, " AND ")
+	if b.orderBy != "" {
+		cql = fmt.Sprintf("%s ORDER BY %s", cql, b.orderBy)
+	}
+	return cql
+}
+
+// AtlassianConfluenceBuildSearchCQL builds the CQL query for the given search options.
+// A raw query in opts.CQL is returned unchanged.
+func AtlassianConfluenceBuildSearchCQL(opts atlassian.AtlassianConfluenceSearchOptions) (string, error) {
+	if opts.CQL != "" {
+		return opts.CQL, nil
+	}
+
+	b := AtlassianConfluenceNewCQLBuilder()
+
+	types := opts.Types
+	if len(types) == 0 {
+		types = []string{"page"}
+	}
+	for _, contentType := range types {
+		if !isConfluenceContentType(contentType) {
+			return "", fmt.Errorf("unsupported content type %q (expected one of: %s)", contentType, strings.Join(AtlassianConfluenceContentTypes, ", "))
+		}
+	}
+	b.WhereIn("type", types)
+
+	if opts.Query != "" {
+		b.Where("text", "~", opts.Query)
+	}
+	if opts.Title != "" {
+		b.Where("title", "~", opts.Title)
+	}
+	if opts.SpaceKey != "" {
+		b.Where("space", "=", opts.SpaceKey)
+	}
+	for _, label := range opts.Labels {
+		b.Where("label", "=", label)
+	}
+	if opts.Contributor != "" {
+		if opts.Contributor == "me" {
+			b.Raw("contributor = currentUser()")
+		} else {
+			b.Where("contributor", "=", opts.Contributor)
+		}
+	}
+	if opts.Ancestor != "" {
+		b.Where("ancestor", "=", opts.Ancestor)
+	}
+	if opts.ModifiedSince != "" {
+		since, err := cqlDate(opts.ModifiedSince)
+		if err != nil {
+			return "", fmt.Errorf("invalid modified-since value: %w", err)
+		}
+		b.Raw("lastmodified >= " + since)
+	}
+
+	if opts.SortBy != "" {
+		sortOrder := "desc"
+		if opts.SortOrder != "" {
+			sortOrder = opts.SortOrder
+		}
+		b.OrderBy(opts.SortBy, sortOrder)
+	}
+
+	return b.String(), nil
+}
+
+// cqlDate converts a YYYY-MM-DD date or a relative duration such as 7d into a CQL date expression
+func cqlDate(value string) (string, error) {
+	if match := relativeCQLDate.FindStringSubmatch(value); match != nil {
+		return fmt.Sprintf(`now("-%s%s")`, match[1], match[2]), nil
+	}
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return "", fmt.Errorf("%q is neither a date (YYYY-MM-DD) nor a relative duration (e.g. 7d, 2w, 3M)", value)
+	}
+	return AtlassianConfluenceCQLQuote(value), nil
+}
+
+// isConfluenceContentType reports whether contentType can be searched with CQL
+func isConfluenceContentType(contentType string) bool {
+	for _, t := range AtlassianConfluenceContentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
@@ -6,9 +6,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"markcli/internal/logging"
 	"markcli/internal/types/atlassian"
+	"markcli/internal/util"
 )
 
 // AtlassianJiraListProjects returns a list of Jira projects
@@ -20,7 +22,7 @@ func (c *Client) AtlassianJiraListProjects() ([]atlassian.AtlassianJiraProject,
 	}
 
 	// Send request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
@@ -66,137 +68,131 @@ func (c *Client) AtlassianJiraListProjects() ([]atlassian.AtlassianJiraProject,
 	return projects, nil
 }
 
-// AtlassianJiraSearchIssues searches for issues in Jira using JQL
-func (c *Client) AtlassianJiraSearchIssues(opts atlassian.AtlassianJiraSearchOptions) (*atlassian.AtlassianJiraSearchResponse, error) {
-	// Build query parameters
-	params := url.Values{}
-	params.Add("jql", opts.Query)
-	params.Add("startAt", fmt.Sprintf("%d", opts.StartAt))
-	params.Add("maxResults", fmt.Sprintf("%d", opts.Limit))
-	params.Add("fields", "summary,status,priority,project,assignee,reporter,description,created,updated,duedate,resolution,issuetype")
+// jiraIssueFields lists the fields requested for issues in search results and issue details
+const jiraIssueFields = "summary,status,priority,project,assignee,reporter,description,created,updated,duedate,resolution,issuetype"
 
-	// Create request
-	req, err := c.newRequest("GET", "/rest/api/3/search?"+params.Encode(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// jiraRequestedFields returns the fields parameter for the standard fields plus extra field IDs
+func jiraRequestedFields(extraFields []string) string {
+	fields := jiraIssueFields
+	for _, id := range extraFields {
+		if id == "*all" {
+			return "*all"
+		}
+		if id != "" && !containsString(strings.Split(fields, ","), id) {
+			fields += "," + id
+		}
 	}
+	return fields
+}
 
-	// Send request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
+// maxJQLQueryLength is the longest encoded query string sent with GET; longer searches are POSTed
+const maxJQLQueryLength = 2000
 
-	// Read response body for logging and error handling
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
+// maxJiraSkipResults is the page size allowed by the search API when only issue IDs are requested
+const maxJiraSkipResults = 5000
 
-	// Log request and response for debugging
-	logging.LogDebug("Request URL: %s", req.URL.String())
-	logging.LogDebug("Response Status: %s", resp.Status)
-	if logging.IsDebugEnabled() {
-		var jsonData interface{}
-		if err := json.Unmarshal(body, &jsonData); err == nil {
-			logging.LogJSONInline("Response Body", jsonData)
-		} else {
-			logging.LogDebug("Response Body: %s", string(body))
+// AtlassianJiraSearchIssues searches for issues in Jira using JQL. Results are paged with
+// opts.NextPageToken; without a token, opts.StartAt results are skipped first so that
+// numbered pages keep working on top of token-based pagination.
+func (c *Client) AtlassianJiraSearchIssues(opts atlassian.AtlassianJiraSearchOptions) (*atlassian.AtlassianJiraSearchResponse, error) {
+	token := opts.NextPageToken
+	if token == "" {
+		// Skip earlier pages cheaply by fetching only IDs
+		for skipped := 0; skipped < opts.StartAt; {
+			page, err := c.atlassianJiraSearchPage(opts.Query, "id", util.Min(opts.StartAt-skipped, maxJiraSkipResults), token)
+			if err != nil {
+				return nil, err
+			}
+			skipped += len(page.Issues)
+			token = page.NextPageToken
+			if page.IsLast || token == "" || len(page.Issues) == 0 {
+				return &atlassian.AtlassianJiraSearchResponse{IsLast: true}, nil
+			}
 		}
 	}
 
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		var errorResp atlassian.AtlassianJiraError
-		if err := json.Unmarshal(body, &errorResp); err == nil {
-			errorResp.StatusCode = resp.StatusCode
-			logging.LogDebug("Jira API Error - Status Code: %d, Error: %+v", resp.StatusCode, errorResp)
-			return nil, &errorResp
+	return c.atlassianJiraSearchPage(opts.Query, jiraRequestedFields(opts.Fields), opts.Limit, token)
+}
+
+// atlassianJiraSearchPage fetches one page of results from the enhanced search endpoint
+func (c *Client) atlassianJiraSearchPage(jql, fields string, limit int, token string) (*atlassian.AtlassianJiraSearchResponse, error) {
+	params := url.Values{}
+	params.Add("jql", jql)
+	params.Add("maxResults", fmt.Sprintf("%d", limit))
+	params.Add("fields", fields)
+	if token != "" {
+		params.Add("nextPageToken", token)
+	}
+
+	var req *http.Request
+	var err error
+	if query := params.Encode(); len(query) <= maxJQLQueryLength {
+		req, err = c.newRequest("GET", "/rest/api/3/search/jql?"+query, nil)
+	} else {
+		// Long queries do not fit in a URL
+		body := map[string]interface{}{
+			"jql":        jql,
+			"maxResults": limit,
+			"fields":     strings.Split(fields, ","),
 		}
-		logging.LogDebug("Jira API Error - Status Code: %d, Body: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+		if token != "" {
+			body["nextPageToken"] = token
+		}
+		req, err = c.newRequest("POST", "/rest/api/3/search/jql", body)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Parse response
 	var result atlassian.AtlassianJiraSearchResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		logging.LogDebug("Failed to decode response: %s", string(body))
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.doJiraRequest(req, &result); err != nil {
+		return nil, err
+	}
+	if result.NextPageToken == "" {
+		result.IsLast = true
 	}
 
 	return &result, nil
 }
 
-// AtlassianJiraGetIssue gets a specific issue by ID from Jira API v3
-func (c *Client) AtlassianJiraGetIssue(issueID string) (*atlassian.AtlassianJiraIssue, error) {
-	// Build query parameters
-	params := url.Values{}
-	params.Add("fields", "summary,status,priority,project,assignee,reporter,description,created,updated,duedate,resolution,issuetype")
-
-	// Create request
-	req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s?%s", issueID, params.Encode()), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// AtlassianJiraCountIssues returns the approximate number of issues matching a JQL query.
+// Recently changed issues may not be counted yet.
+func (c *Client) AtlassianJiraCountIssues(jql string) (int, error) {
+	// Sorting does not change the count
+	body := map[string]string{
+		"jql": jqlWithoutOrderBy(jql),
 	}
 
-	// Send request
-	resp, err := c.httpClient.Do(req)
+	req, err := c.newRequest("POST", "/rest/api/3/search/approximate-count", body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return 0, fmt.Errorf("failed to create request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	// Read response body for logging and error handling
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+	var result struct {
+		Count int `json:"count"`
 	}
-
-	// Log request and response for debugging
-	logging.LogDebug("Request URL: %s", req.URL.String())
-	logging.LogDebug("Response Status: %s", resp.Status)
-	if logging.IsDebugEnabled() {
-		var jsonData interface{}
-		if err := json.Unmarshal(body, &jsonData); err == nil {
-			logging.LogJSONInline("Response Body", jsonData)
-		} else {
-			logging.LogDebug("Response Body: %s", string(body))
-		}
+	if err := c.doJiraRequest(req, &result); err != nil {
+		return 0, err
 	}
 
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		var errorResp atlassian.AtlassianJiraError
-		if err := json.Unmarshal(body, &errorResp); err == nil {
-			errorResp.StatusCode = resp.StatusCode
-			logging.LogDebug("Jira API Error - Status Code: %d, Error: %+v", resp.StatusCode, errorResp)
-			return nil, &errorResp
-		}
-		logging.LogDebug("Jira API Error - Status Code: %d, Body: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
-	}
-
-	// Parse response
-	var issue atlassian.AtlassianJiraIssue
-	if err := json.Unmarshal(body, &issue); err != nil {
-		logging.LogDebug("Failed to decode response: %s", string(body))
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &issue, nil
+	return result.Count, nil
 }
 
-// AtlassianJiraGetIssueComments gets comments for a specific issue
-func (c *Client) AtlassianJiraGetIssueComments(issueID string) (*atlassian.AtlassianJiraCommentsResponse, error) {
+// AtlassianJiraGetIssue gets a specific issue by ID from Jira API v3, including
+// any extra fields by ID ("*all" requests every field)
+func (c *Client) AtlassianJiraGetIssue(issueID string, extraFields ...string) (*atlassian.AtlassianJiraIssue, error) {
+	// Build query parameters
+	params := url.Values{}
+	params.Add("fields", jiraRequestedFields(append([]string{"parent", "subtasks", "issuelinks", "attachment"}, extraFields...)))
+
 	// Create request
-	req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s/comment", issueID), nil)
+	req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s?%s", issueID, params.Encode()), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Send request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
@@ -233,11 +229,11 @@ func (c *Client) AtlassianJiraGetIssueComments(issueID string) (*atlassian.Atlas
 	}
 
 	// Parse response
-	var result atlassian.AtlassianJiraCommentsResponse
-	if err := json.Unmarshal(body, &result); err != nil {
+	var issue atlassian.AtlassianJiraIssue
+	if err := json.Unmarshal(body, &issue); err != nil {
 		logging.LogDebug("Failed to decode response: %s", string(body))
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return &result, nil
+	return &issue, nil
 }
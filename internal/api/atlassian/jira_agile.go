@@ -0,0 +1,215 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"markcli/internal/types/atlassian"
+	"markcli/internal/util"
+)
+
+// maxSprintIssuesPerMove is the most issues the Agile API moves into a sprint per request
+const maxSprintIssuesPerMove = 50
+
+// AtlassianJiraListBoards returns every board, optionally only those of a project or
+// whose name contains the given text
+func (c *Client) AtlassianJiraListBoards(projectKey, name string) ([]atlassian.AtlassianJiraBoard, error) {
+	var boards []atlassian.AtlassianJiraBoard
+	for {
+		params := url.Values{}
+		params.Add("startAt", fmt.Sprintf("%d", len(boards)))
+		params.Add("maxResults", "50")
+		if projectKey != "" {
+			params.Add("projectKeyOrId", projectKey)
+		}
+		if name != "" {
+			params.Add("name", name)
+		}
+
+		req, err := c.newRequest("GET", "/rest/agile/1.0/board?"+params.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			Values []atlassian.AtlassianJiraBoard `json:"values"`
+			IsLast bool                           `json:"isLast"`
+		}
+		if err := c.doJiraRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		boards = append(boards, result.Values...)
+		if result.IsLast || len(result.Values) == 0 {
+			break
+		}
+	}
+	return boards, nil
+}
+
+// AtlassianJiraGetBoardConfiguration returns the configuration of a board, including its estimation field
+func (c *Client) AtlassianJiraGetBoardConfiguration(boardID int) (*atlassian.AtlassianJiraBoardConfiguration, error) {
+	req, err := c.newRequest("GET", fmt.Sprintf("/rest/agile/1.0/board/%d/configuration", boardID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var config atlassian.AtlassianJiraBoardConfiguration
+	if err := c.doJiraRequest(req, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// AtlassianJiraListSprints returns the sprints of a board, optionally only those in the given
+// states ("future", "active", "closed")
+func (c *Client) AtlassianJiraListSprints(boardID int, states []string) ([]atlassian.AtlassianJiraSprint, error) {
+	var sprints []atlassian.AtlassianJiraSprint
+	for {
+		params := url.Values{}
+		params.Add("startAt", fmt.Sprintf("%d", len(sprints)))
+		params.Add("maxResults", "50")
+		if len(states) > 0 {
+			params.Add("state", strings.Join(states, ","))
+		}
+
+		req, err := c.newRequest("GET", fmt.Sprintf("/rest/agile/1.0/board/%d/sprint?%s", boardID, params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			Values []atlassian.AtlassianJiraSprint `json:"values"`
+			IsLast bool                            `json:"isLast"`
+		}
+		if err := c.doJiraRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		sprints = append(sprints, result.Values...)
+		if result.IsLast || len(result.Values) == 0 {
+			break
+		}
+	}
+	return sprints, nil
+}
+
+// AtlassianJiraGetSprint returns a sprint by ID
+func (c *Client) AtlassianJiraGetSprint(sprintID int) (*atlassian.AtlassianJiraSprint, error) {
+	req, err := c.newRequest("GET", fmt.Sprintf("/rest/agile/1.0/sprint/%d", sprintID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var sprint atlassian.AtlassianJiraSprint
+	if err := c.doJiraRequest(req, &sprint); err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// AtlassianJiraGetSprintIssues returns every issue in a sprint, with the standard fields plus extraFields
+func (c *Client) AtlassianJiraGetSprintIssues(sprintID int, extraFields ...string) ([]atlassian.AtlassianJiraIssue, error) {
+	return c.atlassianJiraAgileIssues(fmt.Sprintf("/rest/agile/1.0/sprint/%d/issue", sprintID), 0, extraFields)
+}
+
+// AtlassianJiraGetBacklogIssues returns up to limit issues of the backlog of a board in rank order,
+// with the standard fields plus extraFields. A limit of 0 returns the whole backlog.
+func (c *Client) AtlassianJiraGetBacklogIssues(boardID, limit int, extraFields ...string) ([]atlassian.AtlassianJiraIssue, error) {
+	return c.atlassianJiraAgileIssues(fmt.Sprintf("/rest/agile/1.0/board/%d/backlog", boardID), limit, extraFields)
+}
+
+// atlassianJiraAgileIssues follows the pagination of an Agile API issue list up to limit issues
+func (c *Client) atlassianJiraAgileIssues(path string, limit int, extraFields []string) ([]atlassian.AtlassianJiraIssue, error) {
+	var issues []atlassian.AtlassianJiraIssue
+	for limit <= 0 || len(issues) < limit {
+		pageSize := 100
+		if limit > 0 {
+			pageSize = util.Min(pageSize, limit-len(issues))
+		}
+
+		params := url.Values{}
+		params.Add("startAt", fmt.Sprintf("%d", len(issues)))
+		params.Add("maxResults", fmt.Sprintf("%d", pageSize))
+		params.Add("fields", jiraRequestedFields(extraFields))
+
+		req, err := c.newRequest("GET", path+"?"+params.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			Issues []atlassian.AtlassianJiraIssue `json:"issues"`
+			Total  int                            `json:"total"`
+		}
+		if err := c.doJiraRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		issues = append(issues, result.Issues...)
+		if len(result.Issues) == 0 || len(issues) >= result.Total {
+			break
+		}
+	}
+	return issues, nil
+}
+
+// AtlassianJiraCreateSprint creates a future sprint on a board. Dates are ISO 8601 and optional.
+func (c *Client) AtlassianJiraCreateSprint(boardID int, name, startDate, endDate, goal string) (*atlassian.AtlassianJiraSprint, error) {
+	body := map[string]interface{}{
+		"name":          name,
+		"originBoardId": boardID,
+	}
+	if startDate != "" {
+		body["startDate"] = startDate
+	}
+	if endDate != "" {
+		body["endDate"] = endDate
+	}
+	if goal != "" {
+		body["goal"] = goal
+	}
+
+	req, err := c.newRequest("POST", "/rest/agile/1.0/sprint", body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var sprint atlassian.AtlassianJiraSprint
+	if err := c.doJiraRequest(req, &sprint); err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// AtlassianJiraUpdateSprint changes only the given sprint properties, e.g. {"state": "active"}
+// to start a sprint or {"state": "closed"} to close it
+func (c *Client) AtlassianJiraUpdateSprint(sprintID int, changes map[string]interface{}) (*atlassian.AtlassianJiraSprint, error) {
+	req, err := c.newRequest("POST", fmt.Sprintf("/rest/agile/1.0/sprint/%d", sprintID), changes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var sprint atlassian.AtlassianJiraSprint
+	if err := c.doJiraRequest(req, &sprint); err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// AtlassianJiraMoveIssuesToSprint moves issues into a sprint, in batches of the size the API accepts
+func (c *Client) AtlassianJiraMoveIssuesToSprint(sprintID int, issueKeys []string) error {
+	for start := 0; start < len(issueKeys); start += maxSprintIssuesPerMove {
+		batch := issueKeys[start:util.Min(start+maxSprintIssuesPerMove, len(issueKeys))]
+
+		req, err := c.newRequest("POST", fmt.Sprintf("/rest/agile/1.0/sprint/%d/issue", sprintID), map[string]interface{}{"issues": batch})
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		if err := c.doJiraRequest(req, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
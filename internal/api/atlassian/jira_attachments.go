@@ -0,0 +1,74 @@
+package atlassian
+
+import (
+	"fmt"
+	"io"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianJiraGetAttachments returns the files attached to an issue
+func (c *Client) AtlassianJiraGetAttachments(issueKey string) ([]atlassian.AtlassianJiraAttachment, error) {
+	req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s?fields=attachment", issueKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result struct {
+		Fields struct {
+			Attachments []atlassian.AtlassianJiraAttachment `json:"attachment"`
+		} `json:"fields"`
+	}
+	if err := c.doJiraRequest(req, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Fields.Attachments, nil
+}
+
+// AtlassianJiraDownloadAttachment opens the content of an attachment for streaming.
+// The caller must close the returned reader. The size is -1 when the server does not report it.
+func (c *Client) AtlassianJiraDownloadAttachment(attachment atlassian.AtlassianJiraAttachment) (io.ReadCloser, int64, error) {
+	req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/attachment/content/%s", attachment.ID), nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "*/*")
+
+	resp, err := c.doStreamRequest(req, newJiraError)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	size := resp.ContentLength
+	if size < 0 && attachment.Size > 0 {
+		size = attachment.Size
+	}
+	return resp.Body, size, nil
+}
+
+// AtlassianJiraUploadAttachment attaches a file to an issue. Unlike Confluence, Jira keeps
+// files with the same name side by side instead of versioning them.
+func (c *Client) AtlassianJiraUploadAttachment(issueKey, fileName string, content io.Reader) (*atlassian.AtlassianJiraAttachment, error) {
+	endpoint := fmt.Sprintf("/rest/api/3/issue/%s/attachments", issueKey)
+	req, err := c.newRequest("POST", endpoint, newMultipartBody("file", fileName, content, nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	body, err := c.doRequest(req, newJiraError)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response lists every attachment created by the request
+	var attachments []atlassian.AtlassianJiraAttachment
+	if err := decodeResponse(body, &attachments); err != nil {
+		return nil, err
+	}
+	if len(attachments) == 0 {
+		return nil, fmt.Errorf("upload of %s returned no attachment", fileName)
+	}
+
+	return &attachments[0], nil
+}
@@ -0,0 +1,101 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+
+	"markcli/internal/types/atlassian"
+)
+
+// jsdPublicCommentProperty is the comment property Jira Service Management uses to
+// tell internal comments from comments shared with the customer
+const jsdPublicCommentProperty = "sd.public.comment"
+
+// AtlassianJiraGetIssueComments gets every comment on an issue, following pagination.
+// orderBy is "created" for oldest first or "-created" for newest first.
+func (c *Client) AtlassianJiraGetIssueComments(issueID, orderBy string) (*atlassian.AtlassianJiraCommentsResponse, error) {
+	all := &atlassian.AtlassianJiraCommentsResponse{}
+	for {
+		params := url.Values{}
+		params.Add("startAt", fmt.Sprintf("%d", len(all.Comments)))
+		params.Add("maxResults", "100")
+		if orderBy != "" {
+			params.Add("orderBy", orderBy)
+		}
+
+		req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s/comment?%s", issueID, params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result atlassian.AtlassianJiraCommentsResponse
+		if err := c.doJiraRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		all.Comments = append(all.Comments, result.Comments...)
+		all.Total = result.Total
+		if len(result.Comments) == 0 || len(all.Comments) >= result.Total {
+			break
+		}
+	}
+
+	all.MaxResults = len(all.Comments)
+	return all, nil
+}
+
+// AtlassianJiraAddComment adds a comment to an issue
+func (c *Client) AtlassianJiraAddComment(issueKey string, opts atlassian.AtlassianJiraCommentOptions) (*atlassian.AtlassianJiraComment, error) {
+	req, err := c.newRequest("POST", fmt.Sprintf("/rest/api/3/issue/%s/comment", issueKey), commentRequestBody(opts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var comment atlassian.AtlassianJiraComment
+	if err := c.doJiraRequest(req, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// AtlassianJiraUpdateComment replaces the body of a comment. Visibility and the internal
+// flag are only changed when set in opts.
+func (c *Client) AtlassianJiraUpdateComment(issueKey, commentID string, opts atlassian.AtlassianJiraCommentOptions) (*atlassian.AtlassianJiraComment, error) {
+	req, err := c.newRequest("PUT", fmt.Sprintf("/rest/api/3/issue/%s/comment/%s", issueKey, commentID), commentRequestBody(opts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var comment atlassian.AtlassianJiraComment
+	if err := c.doJiraRequest(req, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// AtlassianJiraDeleteComment deletes a comment from an issue
+func (c *Client) AtlassianJiraDeleteComment(issueKey, commentID string) error {
+	req, err := c.newRequest("DELETE", fmt.Sprintf("/rest/api/3/issue/%s/comment/%s", issueKey, commentID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJiraRequest(req, nil)
+}
+
+// commentRequestBody builds the request body shared by adding and editing comments
+func commentRequestBody(opts atlassian.AtlassianJiraCommentOptions) map[string]interface{} {
+	body := map[string]interface{}{
+		"body": opts.Body,
+	}
+	if opts.Visibility != nil {
+		body["visibility"] = opts.Visibility
+	}
+	if opts.Internal != nil {
+		body["properties"] = []map[string]interface{}{{
+			"key":   jsdPublicCommentProperty,
+			"value": map[string]bool{"internal": *opts.Internal},
+		}}
+	}
+	return body
+}
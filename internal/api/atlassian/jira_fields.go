@@ -0,0 +1,563 @@
+package atlassian
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"markcli/internal/config"
+	"markcli/internal/logging"
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianJiraGetCreateIssueTypes returns the issue types that can be created in a project
+func (c *Client) AtlassianJiraGetCreateIssueTypes(projectKey string) ([]atlassian.AtlassianJiraIssueType, error) {
+	var issueTypes []atlassian.AtlassianJiraIssueType
+	for startAt := 0; ; {
+		params := url.Values{}
+		params.Add("startAt", fmt.Sprintf("%d", startAt))
+		params.Add("maxResults", "50")
+
+		req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/createmeta/%s/issuetypes?%s", url.PathEscape(projectKey), params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			IssueTypes []atlassian.AtlassianJiraIssueType `json:"issueTypes"`
+			Total      int                                `json:"total"`
+		}
+		if err := c.doJiraRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		issueTypes = append(issueTypes, result.IssueTypes...)
+		startAt += len(result.IssueTypes)
+		if len(result.IssueTypes) == 0 || startAt >= result.Total {
+			break
+		}
+	}
+
+	return issueTypes, nil
+}
+
+// AtlassianJiraGetCreateFields returns the fields of the create screen for an issue type in a project
+func (c *Client) AtlassianJiraGetCreateFields(projectKey, issueTypeID string) ([]atlassian.AtlassianJiraFieldMeta, error) {
+	var fields []atlassian.AtlassianJiraFieldMeta
+	for startAt := 0; ; {
+		params := url.Values{}
+		params.Add("startAt", fmt.Sprintf("%d", startAt))
+		params.Add("maxResults", "100")
+
+		req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/createmeta/%s/issuetypes/%s?%s", url.PathEscape(projectKey), issueTypeID, params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			Fields []atlassian.AtlassianJiraFieldMeta `json:"fields"`
+			Total  int                                `json:"total"`
+		}
+		if err := c.doJiraRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, result.Fields...)
+		startAt += len(result.Fields)
+		if len(result.Fields) == 0 || startAt >= result.Total {
+			break
+		}
+	}
+
+	return fields, nil
+}
+
+// AtlassianJiraFindFieldMeta finds a field by ID, key, or case-insensitive name
+func AtlassianJiraFindFieldMeta(fields []atlassian.AtlassianJiraFieldMeta, name string) (*atlassian.AtlassianJiraFieldMeta, bool) {
+	for i, field := range fields {
+		if field.FieldID == name || field.Key == name {
+			return &fields[i], true
+		}
+	}
+	for i, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return &fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// sprintFieldType is the custom field type of the Jira Software sprint field
+const sprintFieldType = "com.pyxis.greenhopper.jira:gh-sprint"
+
+// AtlassianJiraFieldValue converts a value given on the command line into the JSON shape
+// the field expects, based on its schema. Array fields take comma-separated values,
+// cascading selects take "parent > child", and rich text fields take markdown.
+func (c *Client) AtlassianJiraFieldValue(field atlassian.AtlassianJiraFieldMeta, raw string) (interface{}, error) {
+	raw = strings.TrimSpace(raw)
+
+	// The sprint field is described as an array, but takes a single bare sprint ID
+	if field.Schema.Custom == sprintFieldType {
+		id, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects a sprint ID, got %q", field.Name, raw)
+		}
+		return id, nil
+	}
+
+	if field.Schema.Type == "array" {
+		values := []interface{}{}
+		for _, item := range strings.Split(raw, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			value, err := c.atlassianJiraScalarValue(field, field.Schema.Items, item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	}
+
+	return c.atlassianJiraScalarValue(field, field.Schema.Type, raw)
+}
+
+// atlassianJiraScalarValue shapes a single value of the given schema type
+func (c *Client) atlassianJiraScalarValue(field atlassian.AtlassianJiraFieldMeta, valueType, raw string) (interface{}, error) {
+	switch valueType {
+	case "string":
+		if isRichTextField(field) {
+			return atlassian.AtlassianDocumentConvertMarkdownToDocument(raw), nil
+		}
+		return raw, nil
+
+	case "number":
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects a number, got %q", field.Name, raw)
+		}
+		return number, nil
+
+	case "date":
+		if _, err := time.Parse("2006-01-02", raw); err != nil {
+			return nil, fmt.Errorf("%s expects a date (YYYY-MM-DD), got %q", field.Name, raw)
+		}
+		return raw, nil
+
+	case "datetime":
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02"} {
+			if t, err := time.ParseInLocation(layout, raw, time.Local); err == nil {
+				return t.Format("2006-01-02T15:04:05.000-0700"), nil
+			}
+		}
+		return nil, fmt.Errorf("%s expects a date and time (YYYY-MM-DD HH:MM), got %q", field.Name, raw)
+
+	case "user":
+		user, err := c.AtlassianJiraFindUser(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Name, err)
+		}
+		return map[string]string{"accountId": user.AccountID}, nil
+
+	case "project", "issuelink":
+		return map[string]string{"key": strings.ToUpper(raw)}, nil
+
+	case "option-with-child":
+		parent, child, hasChild := strings.Cut(raw, ">")
+		allowed, err := matchAllowedValue(field, field.AllowedValues, strings.TrimSpace(parent))
+		if err != nil {
+			return nil, err
+		}
+		value := map[string]interface{}{"id": allowed.ID}
+		if hasChild {
+			childValue, err := matchAllowedValue(field, allowed.Children, strings.TrimSpace(child))
+			if err != nil {
+				return nil, err
+			}
+			value["child"] = map[string]string{"id": childValue.ID}
+		}
+		return value, nil
+
+	case "option", "priority", "component", "version", "resolution", "issuetype", "securitylevel":
+		if len(field.AllowedValues) > 0 {
+			allowed, err := matchAllowedValue(field, field.AllowedValues, raw)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]string{"id": allowed.ID}, nil
+		}
+		if valueType == "option" {
+			return map[string]string{"value": raw}, nil
+		}
+		return map[string]string{"name": raw}, nil
+	}
+
+	// Anything else accepts raw JSON, or falls back to a plain string
+	if strings.HasPrefix(raw, "{") || strings.HasPrefix(raw, "[") {
+		var value interface{}
+		if err := json.Unmarshal([]byte(raw), &value); err == nil {
+			return value, nil
+		}
+	}
+	return raw, nil
+}
+
+// settableTypes lists the schema types whose values AtlassianJiraSettableValue can convert
+var settableTypes = map[string]bool{
+	"string": true, "number": true, "date": true, "datetime": true, "user": true, "option-with-child": true,
+	"option": true, "priority": true, "component": true, "version": true, "resolution": true,
+	"issuetype": true, "securitylevel": true, "project": true,
+}
+
+// AtlassianJiraCanRestoreField reports whether values of a field can be converted by
+// AtlassianJiraSettableValue, so that a previous value can be set again
+func AtlassianJiraCanRestoreField(field atlassian.AtlassianJiraFieldMeta) bool {
+	if field.Schema.Custom == sprintFieldType {
+		return true
+	}
+	if field.Schema.Type == "array" {
+		return settableTypes[field.Schema.Items]
+	}
+	return settableTypes[field.Schema.Type]
+}
+
+// AtlassianJiraSettableValue converts the value of a field as read from an issue into the
+// shape accepted when setting it, e.g. users become {"accountId": ...} and sprints a bare
+// sprint ID. The second result is false when the value cannot be converted reliably.
+func AtlassianJiraSettableValue(field atlassian.AtlassianJiraFieldMeta, raw json.RawMessage) (json.RawMessage, bool) {
+	if isJSONNull(raw) {
+		return json.RawMessage("null"), true
+	}
+
+	// Issues keep their closed sprints; only the open or future sprint can be set
+	if field.Schema.Custom == sprintFieldType {
+		var sprints []struct {
+			ID    int    `json:"id"`
+			State string `json:"state"`
+		}
+		if err := json.Unmarshal(raw, &sprints); err != nil {
+			return nil, false
+		}
+		for i := len(sprints) - 1; i >= 0; i-- {
+			if sprints[i].State != "closed" {
+				return json.RawMessage(strconv.Itoa(sprints[i].ID)), true
+			}
+		}
+		return json.RawMessage("null"), true
+	}
+
+	if field.Schema.Type == "array" {
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, false
+		}
+		values := make([]json.RawMessage, 0, len(items))
+		for _, item := range items {
+			value, ok := settableScalarValue(field.Schema.Items, item)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, value)
+		}
+		data, err := json.Marshal(values)
+		if err != nil {
+			return nil, false
+		}
+		return data, true
+	}
+
+	return settableScalarValue(field.Schema.Type, raw)
+}
+
+// settableScalarValue reduces a single value of the given schema type to its settable form
+func settableScalarValue(valueType string, raw json.RawMessage) (json.RawMessage, bool) {
+	var value struct {
+		ID        string `json:"id"`
+		AccountID string `json:"accountId"`
+		Child     *struct {
+			ID string `json:"id"`
+		} `json:"child"`
+	}
+
+	if !settableTypes[valueType] {
+		return nil, false
+	}
+
+	switch valueType {
+	case "string", "number", "date", "datetime":
+		// Text, numbers, dates, and rich text documents are set as they are read
+		return raw, true
+
+	case "user":
+		if err := json.Unmarshal(raw, &value); err != nil || value.AccountID == "" {
+			return nil, false
+		}
+		data, err := json.Marshal(map[string]string{"accountId": value.AccountID})
+		return data, err == nil
+
+	case "option-with-child":
+		if err := json.Unmarshal(raw, &value); err != nil || value.ID == "" {
+			return nil, false
+		}
+		result := map[string]interface{}{"id": value.ID}
+		if value.Child != nil && value.Child.ID != "" {
+			result["child"] = map[string]string{"id": value.Child.ID}
+		}
+		data, err := json.Marshal(result)
+		return data, err == nil
+
+	case "option", "priority", "component", "version", "resolution", "issuetype", "securitylevel", "project":
+		if err := json.Unmarshal(raw, &value); err != nil || value.ID == "" {
+			return nil, false
+		}
+		data, err := json.Marshal(map[string]string{"id": value.ID})
+		return data, err == nil
+	}
+
+	return nil, false
+}
+
+// isJSONNull reports whether raw is empty or the JSON null value
+func isJSONNull(raw json.RawMessage) bool {
+	trimmed := strings.TrimSpace(string(raw))
+	return trimmed == "" || trimmed == "null"
+}
+
+// isRichTextField reports whether a text field holds Atlassian Document Format
+func isRichTextField(field atlassian.AtlassianJiraFieldMeta) bool {
+	switch field.Schema.System {
+	case "description", "environment":
+		return true
+	}
+	return strings.HasSuffix(field.Schema.Custom, ":textarea")
+}
+
+// matchAllowedValue finds a value by ID or case-insensitive name among the values a field allows
+func matchAllowedValue(field atlassian.AtlassianJiraFieldMeta, allowed []atlassian.AtlassianJiraAllowedValue, raw string) (*atlassian.AtlassianJiraAllowedValue, error) {
+	for i, value := range allowed {
+		if value.ID == raw || strings.EqualFold(value.Label(), raw) {
+			return &allowed[i], nil
+		}
+	}
+
+	labels := make([]string, 0, len(allowed))
+	for _, value := range allowed {
+		labels = append(labels, value.Label())
+	}
+	return nil, fmt.Errorf("%q is not a valid value for %s; allowed values: %s", raw, field.Name, strings.Join(labels, ", "))
+}
+
+// fieldCatalogMaxAge is how long the field catalog is reused from the cache
+const fieldCatalogMaxAge = 24 * time.Hour
+
+// AtlassianJiraGetFieldCatalog returns every system and custom field of the site.
+// The catalog is cached on disk per site for a day, and in memory for the life of the client.
+func (c *Client) AtlassianJiraGetFieldCatalog() ([]atlassian.AtlassianJiraField, error) {
+	c.fieldMu.Lock()
+	defer c.fieldMu.Unlock()
+
+	if c.fieldCatalog != nil {
+		return c.fieldCatalog, nil
+	}
+
+	cacheName := "jira-fields.json"
+	if u, err := url.Parse(c.baseURL); err == nil && u.Host != "" {
+		cacheName = fmt.Sprintf("jira-fields-%s.json", strings.ReplaceAll(u.Host, ":", "_"))
+	}
+
+	if data, ok := config.ReadCache(cacheName, fieldCatalogMaxAge); ok {
+		var fields []atlassian.AtlassianJiraField
+		if err := json.Unmarshal(data, &fields); err == nil && len(fields) > 0 {
+			c.fieldCatalog = fields
+			return fields, nil
+		}
+	}
+
+	req, err := c.newRequest("GET", "/rest/api/3/field", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var fields []atlassian.AtlassianJiraField
+	if err := c.doJiraRequest(req, &fields); err != nil {
+		return nil, err
+	}
+
+	if data, err := json.Marshal(fields); err == nil {
+		if err := config.WriteCache(cacheName, data); err != nil {
+			logging.LogDebug("Failed to cache field catalog: %v", err)
+		}
+	}
+
+	c.fieldCatalog = fields
+	return fields, nil
+}
+
+// AtlassianJiraFindField finds a field in the catalog by ID, key, case-insensitive name, or JQL clause name
+func AtlassianJiraFindField(catalog []atlassian.AtlassianJiraField, name string) (*atlassian.AtlassianJiraField, bool) {
+	for i, field := range catalog {
+		if field.ID == name || field.Key == name {
+			return &catalog[i], true
+		}
+	}
+	for i, field := range catalog {
+		if strings.EqualFold(field.Name, name) {
+			return &catalog[i], true
+		}
+	}
+	for i, field := range catalog {
+		for _, clause := range field.ClauseNames {
+			if strings.EqualFold(clause, name) {
+				return &catalog[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// AtlassianJiraResolveFields looks up fields by name or ID in the field catalog.
+// "*all" selects every field, and "key" selects the issue key.
+func (c *Client) AtlassianJiraResolveFields(names []string) ([]atlassian.AtlassianJiraField, error) {
+	catalog, err := c.AtlassianJiraGetFieldCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fields: %w", err)
+	}
+
+	var fields []atlassian.AtlassianJiraField
+	var unknown []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == "":
+			continue
+		case name == "*all":
+			for _, field := range catalog {
+				if !seen[field.ID] {
+					seen[field.ID] = true
+					fields = append(fields, field)
+				}
+			}
+			continue
+		case strings.EqualFold(name, "key") || name == "issuekey":
+			if !seen["issuekey"] {
+				seen["issuekey"] = true
+				fields = append(fields, atlassian.AtlassianJiraField{ID: "issuekey", Key: "issuekey", Name: "Key"})
+			}
+			continue
+		}
+
+		field, ok := AtlassianJiraFindField(catalog, name)
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		if !seen[field.ID] {
+			seen[field.ID] = true
+			fields = append(fields, *field)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown fields: %s", strings.Join(unknown, ", "))
+	}
+	return fields, nil
+}
+
+// AtlassianJiraGetEditFields returns the fields that can be edited on an issue
+func (c *Client) AtlassianJiraGetEditFields(issueKey string) ([]atlassian.AtlassianJiraFieldMeta, error) {
+	req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s/editmeta", issueKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result struct {
+		Fields map[string]atlassian.AtlassianJiraFieldMeta `json:"fields"`
+	}
+	if err := c.doJiraRequest(req, &result); err != nil {
+		return nil, err
+	}
+
+	fields := make([]atlassian.AtlassianJiraFieldMeta, 0, len(result.Fields))
+	for id, field := range result.Fields {
+		if field.FieldID == "" {
+			field.FieldID = id
+		}
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].FieldID < fields[j].FieldID })
+
+	return fields, nil
+}
+
+// AtlassianJiraBuildUpdate turns field changes into the "update" payload of an issue edit,
+// resolving field names against the edit screen and shaping values by field type.
+// Fields that exist but are not on the edit screen are reported with the catalog name.
+func (c *Client) AtlassianJiraBuildUpdate(meta []atlassian.AtlassianJiraFieldMeta, changes []atlassian.AtlassianJiraFieldChange) (map[string][]map[string]interface{}, error) {
+	update := make(map[string][]map[string]interface{})
+
+	var problems []string
+	for _, change := range changes {
+		field, ok := AtlassianJiraFindFieldMeta(meta, change.Field)
+		if !ok {
+			problems = append(problems, c.unknownFieldProblem(change.Field))
+			continue
+		}
+		if len(field.Operations) > 0 && !containsString(field.Operations, change.Operation) {
+			problems = append(problems, fmt.Sprintf("%s does not support %s (supported: %s)", field.Name, change.Operation, strings.Join(field.Operations, ", ")))
+			continue
+		}
+
+		value, err := c.AtlassianJiraFieldValue(*field, change.Value)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		// Add and remove work item by item on list fields
+		if items, ok := value.([]interface{}); ok && change.Operation != "set" {
+			for _, item := range items {
+				update[field.ID()] = append(update[field.ID()], map[string]interface{}{change.Operation: item})
+			}
+			continue
+		}
+		if change.Operation == "set" && change.Value == "" {
+			value = nil // An empty value clears the field
+		}
+		update[field.ID()] = append(update[field.ID()], map[string]interface{}{change.Operation: value})
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid changes:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return update, nil
+}
+
+// unknownFieldProblem explains why a field name could not be used for an edit
+func (c *Client) unknownFieldProblem(name string) string {
+	catalog, err := c.AtlassianJiraGetFieldCatalog()
+	if err != nil {
+		logging.LogDebug("Failed to load field catalog: %v", err)
+		return fmt.Sprintf("%s is not a field on the edit screen of this issue", name)
+	}
+	if field, ok := AtlassianJiraFindField(catalog, name); ok {
+		return fmt.Sprintf("%s (%s) is not on the edit screen of this issue", field.Name, field.ID)
+	}
+	return fmt.Sprintf("no field named %s exists", name)
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
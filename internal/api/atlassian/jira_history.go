@@ -0,0 +1,120 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"markcli/internal/types/atlassian"
+	"markcli/internal/util"
+)
+
+// AtlassianJiraGetChangelog returns the whole change history of an issue, oldest first
+func (c *Client) AtlassianJiraGetChangelog(issueKey string) ([]atlassian.AtlassianJiraChangelog, error) {
+	var changelogs []atlassian.AtlassianJiraChangelog
+	for {
+		params := url.Values{}
+		params.Add("startAt", fmt.Sprintf("%d", len(changelogs)))
+		params.Add("maxResults", "100")
+
+		req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s/changelog?%s", issueKey, params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			Values []atlassian.AtlassianJiraChangelog `json:"values"`
+			Total  int                                `json:"total"`
+			IsLast bool                               `json:"isLast"`
+		}
+		if err := c.doJiraRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		changelogs = append(changelogs, result.Values...)
+		if result.IsLast || len(result.Values) == 0 || len(changelogs) >= result.Total {
+			break
+		}
+	}
+	return changelogs, nil
+}
+
+// AtlassianJiraFilterChangelog keeps only the changes to the given fields, matched by
+// case-insensitive name or field ID. Change sets left without changes are dropped.
+func AtlassianJiraFilterChangelog(changelogs []atlassian.AtlassianJiraChangelog, fields []string) []atlassian.AtlassianJiraChangelog {
+	if len(fields) == 0 {
+		return changelogs
+	}
+
+	var filtered []atlassian.AtlassianJiraChangelog
+	for _, changelog := range changelogs {
+		var items []atlassian.AtlassianJiraChangelogItem
+		for _, item := range changelog.Items {
+			for _, field := range fields {
+				if strings.EqualFold(item.Field, field) || strings.EqualFold(item.FieldID, field) {
+					items = append(items, item)
+					break
+				}
+			}
+		}
+		if len(items) > 0 {
+			changelog.Items = items
+			filtered = append(filtered, changelog)
+		}
+	}
+	return filtered
+}
+
+// AtlassianJiraStatusPeriods works out how long an issue spent in each status from its
+// creation date and changelog. The last period is the current status and runs until now.
+func AtlassianJiraStatusPeriods(issue *atlassian.AtlassianJiraIssue, changelogs []atlassian.AtlassianJiraChangelog, now time.Time) []atlassian.AtlassianJiraStatusPeriod {
+	var periods []atlassian.AtlassianJiraStatusPeriod
+	current := atlassian.AtlassianJiraStatusPeriod{
+		Status:  issue.Fields.Status.Name,
+		Entered: issue.Fields.Created,
+	}
+	if issue.Fields.Reporter != nil {
+		current.By = issue.Fields.Reporter.DisplayName
+	}
+
+	first := true
+	for _, changelog := range changelogs {
+		for _, item := range changelog.Items {
+			if item.Field != "status" {
+				continue
+			}
+			// The status before the first transition is the one the issue was created in
+			if first {
+				current.Status = item.FromString
+				first = false
+			}
+			current.Left = changelog.Created
+			periods = append(periods, current)
+
+			current = atlassian.AtlassianJiraStatusPeriod{
+				Status:  item.ToString,
+				Entered: changelog.Created,
+			}
+			if changelog.Author != nil {
+				current.By = changelog.Author.DisplayName
+			}
+		}
+	}
+	periods = append(periods, current)
+
+	for i := range periods {
+		entered, err := util.ParseDate(periods[i].Entered)
+		if err != nil {
+			continue
+		}
+		left := now
+		if periods[i].Left != "" {
+			if left, err = util.ParseDate(periods[i].Left); err != nil {
+				continue
+			}
+		}
+		periods[i].Seconds = int64(left.Sub(entered) / time.Second)
+	}
+	return periods
+}
@@ -0,0 +1,206 @@
+package atlassian
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"markcli/internal/types/atlassian"
+	"markcli/internal/util"
+)
+
+// AtlassianJiraCreateIssue creates an issue from a map of field IDs to JSON values
+func (c *Client) AtlassianJiraCreateIssue(fields map[string]interface{}) (*atlassian.AtlassianJiraCreatedIssue, error) {
+	body := map[string]interface{}{
+		"fields": fields,
+	}
+
+	req, err := c.newRequest("POST", "/rest/api/3/issue", body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var created atlassian.AtlassianJiraCreatedIssue
+	if err := c.doJiraRequest(req, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// AtlassianJiraEditIssue applies an "update" payload of field operations to an issue
+func (c *Client) AtlassianJiraEditIssue(issueKey string, update map[string][]map[string]interface{}) error {
+	body := map[string]interface{}{
+		"update": update,
+	}
+
+	req, err := c.newRequest("PUT", fmt.Sprintf("/rest/api/3/issue/%s", issueKey), body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJiraRequest(req, nil)
+}
+
+// AtlassianJiraSetIssueFields replaces field values of an issue, given by field ID as raw
+// JSON in the form the issue API returns them, e.g. to restore earlier values
+func (c *Client) AtlassianJiraSetIssueFields(issueKey string, fields map[string]json.RawMessage) error {
+	body := map[string]interface{}{
+		"fields": fields,
+	}
+
+	req, err := c.newRequest("PUT", fmt.Sprintf("/rest/api/3/issue/%s", issueKey), body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJiraRequest(req, nil)
+}
+
+// AtlassianJiraGetTransitions returns the transitions available on an issue, including their screen fields
+func (c *Client) AtlassianJiraGetTransitions(issueKey string) ([]atlassian.AtlassianJiraTransition, error) {
+	params := url.Values{}
+	params.Add("expand", "transitions.fields")
+
+	req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s/transitions?%s", issueKey, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result struct {
+		Transitions []atlassian.AtlassianJiraTransition `json:"transitions"`
+	}
+	if err := c.doJiraRequest(req, &result); err != nil {
+		return nil, err
+	}
+
+	// Screen fields are keyed by ID; keep the ID on each field as well
+	for i := range result.Transitions {
+		for id, field := range result.Transitions[i].Fields {
+			if field.FieldID == "" {
+				field.FieldID = id
+				result.Transitions[i].Fields[id] = field
+			}
+		}
+	}
+
+	return result.Transitions, nil
+}
+
+// AtlassianJiraFindTransition matches a transition by ID, by transition name, or by the name
+// of the status it leads to, in that order of preference
+func AtlassianJiraFindTransition(transitions []atlassian.AtlassianJiraTransition, target string) (*atlassian.AtlassianJiraTransition, error) {
+	for i, transition := range transitions {
+		if transition.ID == target || strings.EqualFold(transition.Name, target) {
+			return &transitions[i], nil
+		}
+	}
+	for i, transition := range transitions {
+		if strings.EqualFold(transition.To.Name, target) {
+			return &transitions[i], nil
+		}
+	}
+
+	available := make([]string, 0, len(transitions))
+	for _, transition := range transitions {
+		available = append(available, fmt.Sprintf("%s (to %s)", transition.Name, transition.To.Name))
+	}
+	if len(available) == 0 {
+		return nil, fmt.Errorf("no transitions are available")
+	}
+	return nil, fmt.Errorf("no transition matches %q; available: %s", target, strings.Join(available, ", "))
+}
+
+// AtlassianJiraTransitionIssue moves an issue through a transition, setting screen fields
+// and applying field operations such as adding a comment
+func (c *Client) AtlassianJiraTransitionIssue(issueKey, transitionID string, fields map[string]interface{}, update map[string][]map[string]interface{}) error {
+	body := map[string]interface{}{
+		"transition": map[string]string{"id": transitionID},
+	}
+	if len(fields) > 0 {
+		body["fields"] = fields
+	}
+	if len(update) > 0 {
+		body["update"] = update
+	}
+
+	req, err := c.newRequest("POST", fmt.Sprintf("/rest/api/3/issue/%s/transitions", issueKey), body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJiraRequest(req, nil)
+}
+
+// AtlassianJiraGetIssueTree returns an issue with its descendants down to depth levels,
+// e.g. an epic with its stories and their subtasks. Each level is fetched with one search.
+func (c *Client) AtlassianJiraGetIssueTree(issueKey string, depth int) (*atlassian.AtlassianJiraIssueNode, error) {
+	issue, err := c.AtlassianJiraGetIssue(issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &atlassian.AtlassianJiraIssueNode{Issue: *issue}
+	level := []*atlassian.AtlassianJiraIssueNode{root}
+	for ; depth > 0 && len(level) > 0; depth-- {
+		byKey := make(map[string]*atlassian.AtlassianJiraIssueNode, len(level))
+		keys := make([]string, 0, len(level))
+		for _, node := range level {
+			byKey[node.Issue.Key] = node
+			keys = append(keys, node.Issue.Key)
+		}
+
+		children, err := c.atlassianJiraSearchChildren(keys)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if child.Fields.Parent == nil {
+				continue
+			}
+			if parent, ok := byKey[child.Fields.Parent.Key]; ok {
+				parent.Children = append(parent.Children, atlassian.AtlassianJiraIssueNode{Issue: child})
+			}
+		}
+
+		// Children are appended by value, so collect pointers once a level is complete
+		var next []*atlassian.AtlassianJiraIssueNode
+		for _, node := range level {
+			for i := range node.Children {
+				next = append(next, &node.Children[i])
+			}
+		}
+		level = next
+	}
+
+	return root, nil
+}
+
+// atlassianJiraSearchChildren returns every issue whose parent is one of the given issues
+func (c *Client) atlassianJiraSearchChildren(parentKeys []string) ([]atlassian.AtlassianJiraIssue, error) {
+	var children []atlassian.AtlassianJiraIssue
+	for start := 0; start < len(parentKeys); start += 50 {
+		batch := parentKeys[start:util.Min(start+50, len(parentKeys))]
+		jql := fmt.Sprintf("parent in (%s) ORDER BY rank", strings.Join(batch, ", "))
+
+		token := ""
+		for {
+			result, err := c.AtlassianJiraSearchIssues(atlassian.AtlassianJiraSearchOptions{
+				Query:         jql,
+				Limit:         100,
+				NextPageToken: token,
+				Fields:        []string{"parent"},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get child issues: %w", err)
+			}
+			children = append(children, result.Issues...)
+			if result.IsLast || len(result.Issues) == 0 {
+				break
+			}
+			token = result.NextPageToken
+		}
+	}
+	return children, nil
+}
@@ -0,0 +1,83 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianJiraGetIssueLinkTypes returns the kinds of links that can be created between issues
+func (c *Client) AtlassianJiraGetIssueLinkTypes() ([]atlassian.AtlassianJiraIssueLinkType, error) {
+	req, err := c.newRequest("GET", "/rest/api/3/issueLinkType", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var result struct {
+		IssueLinkTypes []atlassian.AtlassianJiraIssueLinkType `json:"issueLinkTypes"`
+	}
+	if err := c.doJiraRequest(req, &result); err != nil {
+		return nil, err
+	}
+
+	return result.IssueLinkTypes, nil
+}
+
+// AtlassianJiraFindLinkRelation matches a relation such as "blocks" or "is blocked by" against
+// the outward and inward descriptions of the link types, then against their names.
+// reversed is true when the relation is an inward description, i.e. the issues swap sides.
+func AtlassianJiraFindLinkRelation(linkTypes []atlassian.AtlassianJiraIssueLinkType, relation string) (linkType *atlassian.AtlassianJiraIssueLinkType, reversed bool, err error) {
+	for i, t := range linkTypes {
+		if strings.EqualFold(t.Outward, relation) {
+			return &linkTypes[i], false, nil
+		}
+	}
+	for i, t := range linkTypes {
+		if strings.EqualFold(t.Inward, relation) {
+			return &linkTypes[i], true, nil
+		}
+	}
+	for i, t := range linkTypes {
+		if strings.EqualFold(t.Name, relation) {
+			return &linkTypes[i], false, nil
+		}
+	}
+
+	available := make([]string, 0, 2*len(linkTypes))
+	for _, t := range linkTypes {
+		available = append(available, t.Outward)
+		if t.Inward != t.Outward {
+			available = append(available, t.Inward)
+		}
+	}
+	return nil, false, fmt.Errorf("no link type matches %q; available: %s", relation, strings.Join(available, ", "))
+}
+
+// AtlassianJiraLinkIssues links two issues so that fromKey <outward description> toKey,
+// e.g. "PROJ-1 blocks PROJ-2"
+func (c *Client) AtlassianJiraLinkIssues(linkTypeName, fromKey, toKey string) error {
+	// The inward issue is the source of the link and reads with the outward description
+	body := map[string]interface{}{
+		"type":         map[string]string{"name": linkTypeName},
+		"inwardIssue":  map[string]string{"key": fromKey},
+		"outwardIssue": map[string]string{"key": toKey},
+	}
+
+	req, err := c.newRequest("POST", "/rest/api/3/issueLink", body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJiraRequest(req, nil)
+}
+
+// AtlassianJiraDeleteIssueLink deletes a link between two issues
+func (c *Client) AtlassianJiraDeleteIssueLink(linkID string) error {
+	req, err := c.newRequest("DELETE", fmt.Sprintf("/rest/api/3/issueLink/%s", linkID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJiraRequest(req, nil)
+}
@@ -0,0 +1,105 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"markcli/internal/types/atlassian"
+)
+
+// jiraWorklogTimeLayout is the format of the started time of worklogs
+const jiraWorklogTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// AtlassianJiraGetWorklogs returns the worklogs of an issue, oldest first. Zero times leave
+// the range of start times open on that side.
+func (c *Client) AtlassianJiraGetWorklogs(issueKey string, startedAfter, startedBefore time.Time) ([]atlassian.AtlassianJiraWorklog, error) {
+	var worklogs []atlassian.AtlassianJiraWorklog
+	for {
+		params := url.Values{}
+		params.Add("startAt", fmt.Sprintf("%d", len(worklogs)))
+		params.Add("maxResults", "1000")
+		if !startedAfter.IsZero() {
+			params.Add("startedAfter", fmt.Sprintf("%d", startedAfter.UnixMilli()))
+		}
+		if !startedBefore.IsZero() {
+			params.Add("startedBefore", fmt.Sprintf("%d", startedBefore.UnixMilli()))
+		}
+
+		req, err := c.newRequest("GET", fmt.Sprintf("/rest/api/3/issue/%s/worklog?%s", issueKey, params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			Worklogs []atlassian.AtlassianJiraWorklog `json:"worklogs"`
+			Total    int                              `json:"total"`
+		}
+		if err := c.doJiraRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		worklogs = append(worklogs, result.Worklogs...)
+		if len(result.Worklogs) == 0 || len(worklogs) >= result.Total {
+			break
+		}
+	}
+	return worklogs, nil
+}
+
+// AtlassianJiraAddWorklog logs time on an issue. timeSpent uses Jira's duration format,
+// e.g. "1h 30m" or "2d", so days and weeks follow the working hours of the site.
+func (c *Client) AtlassianJiraAddWorklog(issueKey, timeSpent string, started time.Time, comment *atlassian.AtlassianDocument) (*atlassian.AtlassianJiraWorklog, error) {
+	body := map[string]interface{}{
+		"timeSpent": timeSpent,
+		"started":   started.Format(jiraWorklogTimeLayout),
+	}
+	if comment != nil {
+		body["comment"] = comment
+	}
+
+	req, err := c.newRequest("POST", fmt.Sprintf("/rest/api/3/issue/%s/worklog", issueKey), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var worklog atlassian.AtlassianJiraWorklog
+	if err := c.doJiraRequest(req, &worklog); err != nil {
+		return nil, err
+	}
+	return &worklog, nil
+}
+
+// AtlassianJiraGetTimesheet collects the worklogs of a user started between from and to
+// (exclusive) on the given issues. Entries are sorted by start time.
+func (c *Client) AtlassianJiraGetTimesheet(issues []atlassian.AtlassianJiraIssue, accountID string, from, to time.Time) ([]atlassian.AtlassianJiraTimesheetEntry, error) {
+	var entries []atlassian.AtlassianJiraTimesheetEntry
+	for _, issue := range issues {
+		worklogs, err := c.AtlassianJiraGetWorklogs(issue.Key, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get worklogs of %s: %w", issue.Key, err)
+		}
+		for _, worklog := range worklogs {
+			if worklog.Author == nil || worklog.Author.AccountID != accountID {
+				continue
+			}
+			started, err := time.Parse(jiraWorklogTimeLayout, worklog.Started)
+			if err != nil || started.Before(from) || !started.Before(to) {
+				continue
+			}
+			entries = append(entries, atlassian.AtlassianJiraTimesheetEntry{
+				IssueKey: issue.Key,
+				Summary:  issue.Fields.Summary,
+				Worklog:  worklog,
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, _ := time.Parse(jiraWorklogTimeLayout, entries[i].Worklog.Started)
+		b, _ := time.Parse(jiraWorklogTimeLayout, entries[j].Worklog.Started)
+		return a.Before(b)
+	})
+	return entries, nil
+}
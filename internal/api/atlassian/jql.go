@@ -0,0 +1,232 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"markcli/internal/types/atlassian"
+)
+
+// relativeJQLDate matches relative dates such as 30m, 12h, 7d or 2w
+var relativeJQLDate = regexp.MustCompile(`^(\d+)([mhdw])$`)
+
+// jqlOrderBy matches a sort clause: a field name optionally followed by a direction
+var jqlOrderBy = regexp.MustCompile(`(?i)^\s*(.+?)(?:\s+(asc|desc))?\s*$`)
+
+// jqlOrderByKeyword matches the ORDER BY keywords at the start of a string
+var jqlOrderByKeyword = regexp.MustCompile(`(?i)^ORDER\s+BY\b`)
+
+// AtlassianJiraJQLQuote quotes a value as a JQL string literal, escaping
+// backslashes and double quotes so user input cannot alter the query
+func AtlassianJiraJQLQuote(value string) string {
+	escaped := strings.ReplaceAll(value, `\`, `\\`)
+	escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+	return `"` + escaped + `"`
+}
+
+// AtlassianJiraJQLBuilder composes a JQL query from clauses joined with AND
+type AtlassianJiraJQLBuilder struct {
+	clauses []string
+	orderBy []string
+}
+
+// AtlassianJiraNewJQLBuilder creates an empty JQL builder
+func AtlassianJiraNewJQLBuilder() *AtlassianJiraJQLBuilder {
+	return &AtlassianJiraJQLBuilder{}
+}
+
+// Where adds a clause comparing field to a quoted value, e.g. Where("text", "~", "timeout")
+func (b *AtlassianJiraJQLBuilder) Where(field, operator, value string) *AtlassianJiraJQLBuilder {
+	b.clauses = append(b.clauses, fmt.Sprintf("%s %s %s", field, operator, AtlassianJiraJQLQuote(value)))
+	return b
+}
+
+// WhereIn adds a clause matching any of the quoted values
+func (b *AtlassianJiraJQLBuilder) WhereIn(field string, values []string) *AtlassianJiraJQLBuilder {
+	if len(values) == 1 {
+		return b.Where(field, "=", values[0])
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = AtlassianJiraJQLQuote(value)
+	}
+	b.clauses = append(b.clauses, fmt.Sprintf("%s in (%s)", field, strings.Join(quoted, ", ")))
+	return b
+}
+
+// Raw adds a clause verbatim. Only use it for values that are not user input, such as function calls.
+func (b *AtlassianJiraJQLBuilder) Raw(clause string) *AtlassianJiraJQLBuilder {
+	b.clauses = append(b.clauses, clause)
+	return b
+}
+
+// OrderBy adds a sort field and direction; later calls add secondary sort keys.
+// Field names that are not plain identifiers, such as "Story Points", are quoted.
+func (b *AtlassianJiraJQLBuilder) OrderBy(field, order string) *AtlassianJiraJQLBuilder {
+	field = strings.TrimSpace(field)
+	if len(field) >= 2 && strings.HasPrefix(field, `"`) && strings.HasSuffix(field, `"`) {
+		field = field[1 : len(field)-1]
+	}
+	if !isJQLIdentifier(field) {
+		field = AtlassianJiraJQLQuote(field)
+	}
+	b.orderBy = append(b.orderBy, strings.TrimSpace(field+" "+strings.ToUpper(order)))
+	return b
+}
+
+// Empty reports whether no clauses have been added
+func (b *AtlassianJiraJQLBuilder) Empty() bool {
+	return len(b.clauses) == 0
+}
+
+// String returns the JQL query
+func (b *AtlassianJiraJQLBuilder) String() string {
+	jql := strings.Join(b.clauses, " AND ")
+	if len(b.orderBy) > 0 {
+		jql = strings.TrimSpace(fmt.Sprintf("%s ORDER BY %s", jql, strings.Join(b.orderBy, ", ")))
+	}
+	return jql
+}
+
+// AtlassianJiraBuildSearchJQL builds the JQL query for the given filters.
+// A raw query in filters.JQL is returned unchanged.
+func AtlassianJiraBuildSearchJQL(filters atlassian.AtlassianJiraSearchFilters) (string, error) {
+	if filters.JQL != "" {
+		return filters.JQL, nil
+	}
+
+	b := AtlassianJiraNewJQLBuilder()
+
+	if filters.Project != "" {
+		b.Where("project", "=", filters.Project)
+	}
+	if filters.Text != "" {
+		b.Where("text", "~", filters.Text)
+	}
+	if len(filters.Statuses) > 0 {
+		b.WhereIn("status", filters.Statuses)
+	}
+	if len(filters.Types) > 0 {
+		b.WhereIn("issuetype", filters.Types)
+	}
+	for _, label := range filters.Labels {
+		b.Where("labels", "=", label)
+	}
+
+	switch strings.ToLower(filters.Assignee) {
+	case "":
+	case "me":
+		b.Raw("assignee = currentUser()")
+	case "none", "unassigned":
+		b.Raw("assignee IS EMPTY")
+	default:
+		b.Where("assignee", "=", filters.Assignee)
+	}
+
+	switch strings.ToLower(filters.Sprint) {
+	case "":
+	case "current", "open":
+		b.Raw("sprint in openSprints()")
+	case "future":
+		b.Raw("sprint in futureSprints()")
+	case "closed":
+		b.Raw("sprint in closedSprints()")
+	default:
+		// Sprint IDs are numbers; anything else is a sprint name
+		if _, err := strconv.Atoi(filters.Sprint); err == nil {
+			b.Raw("sprint = " + filters.Sprint)
+		} else {
+			b.Where("sprint", "=", filters.Sprint)
+		}
+	}
+
+	if filters.UpdatedSince != "" {
+		since, err := jqlDate(filters.UpdatedSince)
+		if err != nil {
+			return "", fmt.Errorf("invalid updated-since value: %w", err)
+		}
+		b.Raw("updated >= " + since)
+	}
+	if filters.CreatedSince != "" {
+		since, err := jqlDate(filters.CreatedSince)
+		if err != nil {
+			return "", fmt.Errorf("invalid created-since value: %w", err)
+		}
+		b.Raw("created >= " + since)
+	}
+
+	// Each comma-separated key is a field with an optional direction
+	if filters.OrderBy != "" {
+		for _, key := range strings.Split(filters.OrderBy, ",") {
+			match := jqlOrderBy.FindStringSubmatch(key)
+			if match == nil || strings.TrimSpace(match[1]) == "" {
+				return "", fmt.Errorf("invalid order-by value %q", filters.OrderBy)
+			}
+			b.OrderBy(match[1], match[2])
+		}
+	}
+
+	return b.String(), nil
+}
+
+// jqlWithoutOrderBy removes the ORDER BY clause from a query. ORDER BY inside quoted
+// values is ignored, and quoted sort fields such as "Story Points" are removed with the clause.
+func jqlWithoutOrderBy(jql string) string {
+	var quote byte
+	escaped := false
+	for i := 0; i < len(jql); i++ {
+		ch := jql[i]
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case ch == '\\':
+				escaped = true
+			case ch == quote:
+				quote = 0
+			}
+			continue
+		}
+		if ch == '"' || ch == '\'' {
+			quote = ch
+			continue
+		}
+		// ORDER BY always ends the query, so the first one outside quotes starts the clause
+		if (i == 0 || !isJQLWordByte(jql[i-1])) && jqlOrderByKeyword.MatchString(jql[i:]) {
+			return strings.TrimSpace(jql[:i])
+		}
+	}
+	return jql
+}
+
+// isJQLWordByte reports whether ch can be part of an unquoted JQL word
+func isJQLWordByte(ch byte) bool {
+	return ch == '_' || ch == '.' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+}
+
+// jqlDate converts a YYYY-MM-DD date or a relative duration such as 7d into a JQL date expression
+func jqlDate(value string) (string, error) {
+	if match := relativeJQLDate.FindStringSubmatch(value); match != nil {
+		return fmt.Sprintf(`"-%s%s"`, match[1], match[2]), nil
+	}
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return "", fmt.Errorf("%q is neither a date (YYYY-MM-DD) nor a relative duration (e.g. 7d, 2w)", value)
+	}
+	return AtlassianJiraJQLQuote(value), nil
+}
+
+// isJQLIdentifier reports whether a field name can be used in JQL without quoting
+func isJQLIdentifier(field string) bool {
+	if field == "" {
+		return false
+	}
+	for _, r := range field {
+		if !(r == '_' || r == '.' || r == '[' || r == ']' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,220 @@
+package atlassian
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"markcli/internal/types/atlassian"
+)
+
+var (
+	// jiraIssueKeyPattern matches issue keys such as PROJ-123
+	jiraIssueKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+	// confluenceIDPattern matches numeric content IDs
+	confluenceIDPattern = regexp.MustCompile(`^[0-9]+$`)
+	// confluenceSpaceTitlePattern matches SPACE:Title references
+	confluenceSpaceTitlePattern = regexp.MustCompile(`^([A-Za-z0-9~_]+):(.+)$`)
+)
+
+// AtlassianParseReference parses a Confluence or Jira reference without contacting the API.
+// It accepts web URLs (page, blog post, viewpage.action, display/SPACE/Title, tiny /x/ links,
+// browse and board links), bare page IDs, issue keys and SPACE:Title pairs.
+func AtlassianParseReference(ref string) (*atlassian.AtlassianReference, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, fmt.Errorf("empty reference")
+	}
+
+	if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL %q: %w", ref, err)
+		}
+		parsed, err := parseReferencePath(u.Path, u.Query())
+		if err != nil {
+			return nil, fmt.Errorf("unrecognized Atlassian URL %q: %w", ref, err)
+		}
+		parsed.Host = u.Host
+		return parsed, nil
+	}
+
+	switch {
+	case confluenceSpaceTitlePattern.MatchString(ref):
+		match := confluenceSpaceTitlePattern.FindStringSubmatch(ref)
+		return &atlassian.AtlassianReference{Product: "confluence", SpaceKey: match[1], Title: strings.TrimSpace(match[2])}, nil
+	case confluenceIDPattern.MatchString(ref):
+		return &atlassian.AtlassianReference{Product: "confluence", PageID: ref}, nil
+	case jiraIssueKeyPattern.MatchString(ref):
+		return &atlassian.AtlassianReference{Product: "jira", IssueKey: strings.ToUpper(ref)}, nil
+	case strings.Contains(ref, "/"):
+		// Paths copied without the host, e.g. /x/AbCd or display/SPACE/Title
+		u, err := url.Parse("/" + strings.TrimPrefix(ref, "/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reference %q: %w", ref, err)
+		}
+		parsed, err := parseReferencePath(u.Path, u.Query())
+		if err != nil {
+			return nil, fmt.Errorf("unrecognized reference %q: %w", ref, err)
+		}
+		return parsed, nil
+	}
+
+	return nil, fmt.Errorf("unrecognized reference %q: expected a URL, page ID, issue key or SPACE:Title", ref)
+}
+
+// parseReferencePath extracts a reference from the path and query of a Confluence or Jira URL
+func parseReferencePath(path string, query url.Values) (*atlassian.AtlassianReference, error) {
+	// Jira board and search views keep the open issue in a query parameter
+	if key := query.Get("selectedIssue"); jiraIssueKeyPattern.MatchString(key) {
+		return &atlassian.AtlassianReference{Product: "jira", IssueKey: strings.ToUpper(key)}, nil
+	}
+
+	// Confluence legacy links carry the ID in a query parameter
+	if pageID := query.Get("pageId"); confluenceIDPattern.MatchString(pageID) {
+		return &atlassian.AtlassianReference{Product: "confluence", PageID: pageID}, nil
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 0 && segments[0] == "wiki" {
+		segments = segments[1:]
+	}
+	if len(segments) == 0 || segments[0] == "" {
+		return nil, fmt.Errorf("no content in path")
+	}
+
+	switch segments[0] {
+	case "x":
+		// Tiny links: /x/AbCd
+		if len(segments) < 2 {
+			return nil, fmt.Errorf("tiny link without code")
+		}
+		pageID, err := AtlassianConfluenceDecodeTinyLink(segments[1])
+		if err != nil {
+			return nil, err
+		}
+		return &atlassian.AtlassianReference{Product: "confluence", PageID: pageID}, nil
+	case "display":
+		// Legacy title links: /display/SPACE/Page+Title
+		if len(segments) < 3 {
+			return nil, fmt.Errorf("display link without page title")
+		}
+		title, err := url.QueryUnescape(strings.Join(segments[2:], "/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid page title in display link: %w", err)
+		}
+		return &atlassian.AtlassianReference{Product: "confluence", SpaceKey: segments[1], Title: title}, nil
+	case "spaces":
+		// /spaces/SPACE/pages/123/Title, /spaces/SPACE/pages/edit-v2/123,
+		// /spaces/SPACE/blog/2024/01/31/123/Title, /spaces/SPACE/whiteboard/123 and similar
+		for i := 2; i < len(segments); i++ {
+			if confluenceIDPattern.MatchString(segments[i]) && !isBlogDateSegment(segments, i) {
+				return &atlassian.AtlassianReference{
+					Product:     "confluence",
+					PageID:      segments[i],
+					ContentType: spaceLinkContentType(segments[2]),
+					SpaceKey:    segments[1],
+				}, nil
+			}
+		}
+		return nil, fmt.Errorf("no content ID in space link")
+	}
+
+	// Jira links: /browse/PROJ-123, /jira/software/projects/PROJ/issues/PROJ-123 and similar
+	for i := len(segments) - 1; i >= 0; i-- {
+		if jiraIssueKeyPattern.MatchString(segments[i]) {
+			return &atlassian.AtlassianReference{Product: "jira", IssueKey: strings.ToUpper(segments[i])}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no page ID or issue key in path")
+}
+
+// spaceLinkContentType maps the content segment of a /spaces/SPACE/... link to a Confluence content type
+func spaceLinkContentType(segment string) string {
+	switch segment {
+	case "pages":
+		return "page"
+	case "blog":
+		return "blogpost"
+	}
+	return segment
+}
+
+// isBlogDateSegment reports whether segments[i] is part of the /blog/YYYY/MM/DD/ prefix of a blog post link
+func isBlogDateSegment(segments []string, i int) bool {
+	for j := i - 1; j >= 0 && j >= i-3; j-- {
+		if segments[j] == "blog" {
+			return true
+		}
+	}
+	return false
+}
+
+// AtlassianConfluenceDecodeTinyLink decodes the code of a Confluence tiny link (/x/AbCd) into a page ID.
+// The code is the page ID as a little-endian integer in URL-safe base64, with trailing zero bytes trimmed.
+func AtlassianConfluenceDecodeTinyLink(code string) (string, error) {
+	encoded := strings.NewReplacer("-", "/", "_", "+").Replace(code)
+	if len(encoded) == 0 || len(encoded) > 11 {
+		return "", fmt.Errorf("invalid tiny link code %q", code)
+	}
+	encoded += strings.Repeat("A", 11-len(encoded)) + "="
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("invalid tiny link code %q: %w", code, err)
+	}
+	return fmt.Sprintf("%d", binary.LittleEndian.Uint64(raw)), nil
+}
+
+// AtlassianConfluenceResolvePageID resolves any Confluence reference accepted by
+// AtlassianParseReference to a content ID, looking up SPACE:Title references with CQL
+func (c *Client) AtlassianConfluenceResolvePageID(ref string) (string, error) {
+	parsed, err := AtlassianParseReference(ref)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Product != "confluence" {
+		return "", fmt.Errorf("%q refers to a Jira issue, not Confluence content", ref)
+	}
+	if parsed.PageID != "" {
+		return parsed.PageID, nil
+	}
+
+	cql := AtlassianConfluenceNewCQLBuilder().
+		WhereIn("type", []string{"page", "blogpost"}).
+		Where("space", "=", parsed.SpaceKey).
+		Where("title", "=", parsed.Title).
+		String()
+
+	results, err := c.AtlassianConfluenceSearchPages(atlassian.AtlassianConfluenceSearchOptions{CQL: cql, Limit: 2})
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %q: %w", ref, err)
+	}
+	if len(results.Results) == 0 {
+		return "", fmt.Errorf("no page titled %q in space %s", parsed.Title, parsed.SpaceKey)
+	}
+	if len(results.Results) > 1 {
+		return "", fmt.Errorf("several pages titled %q in space %s; use the page ID instead", parsed.Title, parsed.SpaceKey)
+	}
+	return results.Results[0].Content.ID, nil
+}
+
+// AtlassianJiraResolveIssueKey resolves any Jira reference accepted by AtlassianParseReference to an issue key.
+// Numeric issue IDs are returned unchanged.
+func AtlassianJiraResolveIssueKey(ref string) (string, error) {
+	if confluenceIDPattern.MatchString(strings.TrimSpace(ref)) {
+		return strings.TrimSpace(ref), nil
+	}
+	parsed, err := AtlassianParseReference(ref)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Product != "jira" {
+		return "", fmt.Errorf("%q refers to Confluence content, not a Jira issue", ref)
+	}
+	return parsed.IssueKey, nil
+}
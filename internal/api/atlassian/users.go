@@ -0,0 +1,226 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"markcli/internal/logging"
+	"markcli/internal/types/atlassian"
+)
+
+// AtlassianConfluenceGetUser returns the Confluence user with the given account ID.
+// Users are cached on the client, so repeated lookups only hit the API once.
+func (c *Client) AtlassianConfluenceGetUser(accountID string) (*atlassian.AtlassianConfluenceUser, error) {
+	c.userMu.Lock()
+	if user, ok := c.userCache[accountID]; ok {
+		c.userMu.Unlock()
+		return &user, nil
+	}
+	c.userMu.Unlock()
+
+	params := url.Values{}
+	params.Add("accountId", accountID)
+
+	req, err := c.newRequest("GET", "/wiki/rest/api/user?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var user atlassian.AtlassianConfluenceUser
+	if err := c.doConfluenceRequest(req, &user); err != nil {
+		return nil, err
+	}
+
+	c.userMu.Lock()
+	c.userCache[accountID] = user
+	c.userMu.Unlock()
+
+	return &user, nil
+}
+
+// AtlassianConfluenceUserDisplayName returns a display name for the account ID,
+// falling back to the account ID itself when the user cannot be resolved.
+func (c *Client) AtlassianConfluenceUserDisplayName(accountID string) string {
+	if accountID == "" {
+		return ""
+	}
+	user, err := c.AtlassianConfluenceGetUser(accountID)
+	if err != nil {
+		logging.LogDebug("Failed to resolve user %s: %v", accountID, err)
+		return accountID
+	}
+	if user.DisplayName == "" {
+		return accountID
+	}
+	return user.DisplayName
+}
+
+// AtlassianConfluenceFindUser resolves "me", an account ID, an email address, or a name to a user.
+// Emails and names must match exactly, ignoring case. Found users are added to the user cache.
+func (c *Client) AtlassianConfluenceFindUser(query string) (*atlassian.AtlassianConfluenceUser, error) {
+	if isAccountID(query) {
+		return c.AtlassianConfluenceGetUser(query)
+	}
+
+	var user atlassian.AtlassianConfluenceUser
+	switch {
+	case query == "me":
+		req, err := c.newRequest("GET", "/wiki/rest/api/user/current", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		if err := c.doConfluenceRequest(req, &user); err != nil {
+			return nil, err
+		}
+
+	case strings.Contains(query, "@"):
+		// Confluence cannot search by email, but the site's user directory can
+		jiraUser, err := c.AtlassianJiraFindUser(query)
+		if err != nil {
+			return nil, err
+		}
+		return c.AtlassianConfluenceGetUser(jiraUser.AccountID)
+
+	default:
+		params := url.Values{}
+		params.Add("cql", fmt.Sprintf("user.fullname ~ %s", AtlassianConfluenceCQLQuote(query)))
+		params.Add("limit", fmt.Sprintf("%d", maxUserCandidates))
+		req, err := c.newRequest("GET", "/wiki/rest/api/search/user?"+params.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var result struct {
+			Results []struct {
+				User atlassian.AtlassianConfluenceUser `json:"user"`
+			} `json:"results"`
+		}
+		if err := c.doConfluenceRequest(req, &result); err != nil {
+			return nil, err
+		}
+
+		// The search is fuzzy, so only accept users whose name is exactly the query
+		var matches []atlassian.AtlassianConfluenceUser
+		var found, candidates []string
+		for _, r := range result.Results {
+			found = append(found, describeUser(r.User.DisplayName, r.User.Email, r.User.AccountID))
+			if userMatchesQuery(query, r.User.AccountID, r.User.Email, r.User.DisplayName) ||
+				strings.EqualFold(query, r.User.PublicName) {
+				matches = append(matches, r.User)
+				candidates = append(candidates, found[len(found)-1])
+			}
+		}
+		if err := checkUserMatches(query, len(matches), candidates, found); err != nil {
+			return nil, err
+		}
+		user = matches[0]
+	}
+
+	c.userMu.Lock()
+	c.userCache[user.AccountID] = user
+	c.userMu.Unlock()
+
+	return &user, nil
+}
+
+// maxUserCandidates is the number of search results considered when looking up a user by name
+const maxUserCandidates = 25
+
+// userMatchesQuery reports whether the query is exactly, ignoring case, the account ID, email or display name of a user
+func userMatchesQuery(query, accountID, email, displayName string) bool {
+	return strings.EqualFold(query, accountID) ||
+		(email != "" && strings.EqualFold(query, email)) ||
+		strings.EqualFold(query, displayName)
+}
+
+// describeUser formats a user for error messages, e.g. "Jane Doe <jane@example.com> (5b10ac8d82e05b22cc7d4ef5)"
+func describeUser(displayName, email, accountID string) string {
+	if email != "" {
+		return fmt.Sprintf("%s <%s> (%s)", displayName, email, accountID)
+	}
+	return fmt.Sprintf("%s (%s)", displayName, accountID)
+}
+
+// checkUserMatches returns an error unless exactly one user matched the query.
+// candidates describes the exact matches and found every user the search returned.
+func checkUserMatches(query string, matches int, candidates, found []string) error {
+	switch {
+	case matches == 1:
+		return nil
+	case matches > 1:
+		return fmt.Errorf("several users match %s; use the email address or account ID instead:\n  %s",
+			query, strings.Join(candidates, "\n  "))
+	case len(found) > 0:
+		return fmt.Errorf("no user found for %s; similar users:\n  %s", query, strings.Join(found, "\n  "))
+	}
+	return fmt.Errorf("no user found for %s", query)
+}
+
+// isAccountID reports whether the value looks like an Atlassian account ID,
+// such as "5b10ac8d82e05b22cc7d4ef5" or "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077"
+func isAccountID(value string) bool {
+	if len(value) < 20 {
+		return false
+	}
+	for _, r := range value {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r == ':' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// AtlassianJiraFindUser resolves "me", an account ID, an email address, or a name to a Jira user.
+// Emails and names must match exactly, ignoring case.
+func (c *Client) AtlassianJiraFindUser(query string) (*atlassian.AtlassianJiraUser, error) {
+	var endpoint string
+	switch {
+	case query == "me":
+		endpoint = "/rest/api/3/myself"
+	case isAccountID(query):
+		params := url.Values{}
+		params.Add("accountId", query)
+		endpoint = "/rest/api/3/user?" + params.Encode()
+	default:
+		params := url.Values{}
+		params.Add("query", query)
+		params.Add("maxResults", fmt.Sprintf("%d", maxUserCandidates))
+		req, err := c.newRequest("GET", "/rest/api/3/user/search?"+params.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var users []atlassian.AtlassianJiraUser
+		if err := c.doJiraRequest(req, &users); err != nil {
+			return nil, err
+		}
+
+		// The search is fuzzy, so only accept users whose email or name is exactly the query
+		var matches []atlassian.AtlassianJiraUser
+		var found, candidates []string
+		for _, u := range users {
+			found = append(found, describeUser(u.DisplayName, u.EmailAddress, u.AccountID))
+			if userMatchesQuery(query, u.AccountID, u.EmailAddress, u.DisplayName) {
+				matches = append(matches, u)
+				candidates = append(candidates, found[len(found)-1])
+			}
+		}
+		if err := checkUserMatches(query, len(matches), candidates, found); err != nil {
+			return nil, err
+		}
+		return &matches[0], nil
+	}
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var user atlassian.AtlassianJiraUser
+	if err := c.doJiraRequest(req, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// cacheDir returns the directory for cached API data, such as Jira field catalogs
+func cacheDir() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "cache"), nil
+}
+
+// ReadCache returns the cached data stored under name if it is younger than maxAge
+func ReadCache(name string, maxAge time.Duration) ([]byte, bool) {
+	dir, err := cacheDir()
+	if err != nil {
+		return nil, false
+	}
+
+	path := filepath.Join(dir, name)
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > maxAge {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// WriteCache stores data under name in the cache directory
+func WriteCache(name string, data []byte) error {
+	dir, err := cacheDir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
@@ -3,9 +3,11 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/mitchellh/go-homedir"
 )
@@ -21,10 +23,18 @@ type Config struct {
 
 // AtlassianConfig represents Atlassian site configuration
 type AtlassianConfig struct {
-	SiteName string `json:"site_name"`
-	BaseURL  string `json:"base_url"`
-	Email    string `json:"email"`
-	Token    string `json:"token"`
+	SiteName   string               `json:"site_name"`
+	BaseURL    string               `json:"base_url"`
+	Email      string               `json:"email"`
+	Token      string               `json:"token"`
+	JiraFields *JiraFieldSetsConfig `json:"jira_fields,omitempty"`
+}
+
+// JiraFieldSetsConfig lists the Jira fields shown by default on a site, by name or ID
+type JiraFieldSetsConfig struct {
+	Issue   []string `json:"issue,omitempty"`   // Extra fields shown in issue details
+	Search  []string `json:"search,omitempty"`  // Extra fields shown in search results
+	Columns []string `json:"columns,omitempty"` // Columns of the search results table
 }
 
 // configDir returns the path to the config directory
@@ -129,6 +139,27 @@ func GetAtlassianConfig(siteName string) (*AtlassianConfig, error) {
 	return nil, fmt.Errorf("no Atlassian configuration found for site: %s", siteName)
 }
 
+// FindAtlassianSiteByHost returns the name of the configured Atlassian site whose
+// base URL has the given host, e.g. mycompany.atlassian.net
+func FindAtlassianSiteByHost(host string) (string, error) {
+	cfg, err := Load()
+	if err != nil {
+		return "", fmt.Errorf("failed to load config: %w", err)
+	}
+
+	for name, site := range cfg.Atlassian {
+		u, err := url.Parse(site.BaseURL)
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(u.Host, host) {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("no Atlassian site configured for host: %s", host)
+}
+
 // ListAtlassianSites returns a list of configured Atlassian site names
 func ListAtlassianSites() ([]string, error) {
 	cfg, err := Load()
@@ -0,0 +1,287 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Operation identifies the kind of change an Edit represents
+type Operation int
+
+const (
+	// Equal marks a token present in both inputs
+	Equal Operation = iota
+	// Delete marks a token only present in the old input
+	Delete
+	// Insert marks a token only present in the new input
+	Insert
+)
+
+// Edit represents a single token in an edit script
+type Edit struct {
+	Op   Operation
+	Text string
+}
+
+// Compute returns the shortest edit script turning a into b using Myers' algorithm
+func Compute(a, b []string) []Edit {
+	n, m := len(a), len(b)
+	if n == 0 && m == 0 {
+		return nil
+	}
+
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	var trace [][]int
+
+	for d := 0; d <= max; d++ {
+		// Keep the part of v the backtracking step will need for this d
+		lo, hi := offset-d-1, offset+d+2
+		snapshot := make([]int, hi-lo)
+		copy(snapshot, v[lo:hi])
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace)
+			}
+		}
+	}
+
+	return nil
+}
+
+// backtrack walks the recorded traces from the end to rebuild the edit script
+func backtrack(a, b []string, trace [][]int) []Edit {
+	var edits []Edit
+	x, y := len(a), len(b)
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			edits = append(edits, Edit{Op: Equal, Text: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, Edit{Op: Insert, Text: b[y-1]})
+			} else {
+				edits = append(edits, Edit{Op: Delete, Text: a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	// Edits were collected back to front
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
+
+// SplitLines splits text into lines without their trailing newline
+func SplitLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// SplitWords splits text into alternating runs of whitespace and non-whitespace
+func SplitWords(text string) []string {
+	var tokens []string
+	var current strings.Builder
+	inSpace := false
+
+	for i, r := range text {
+		space := unicode.IsSpace(r)
+		if i > 0 && space != inSpace {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+		inSpace = space
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// HasChanges reports whether the edit script contains any insertions or deletions
+func HasChanges(edits []Edit) bool {
+	for _, edit := range edits {
+		if edit.Op != Equal {
+			return true
+		}
+	}
+	return false
+}
+
+// Unified renders a line diff of a and b in unified diff format with the given
+// number of context lines around each change. It returns an empty string when
+// both inputs are identical.
+func Unified(fromName, toName, a, b string, context int) string {
+	edits := Compute(SplitLines(a), SplitLines(b))
+	if !HasChanges(edits) {
+		return ""
+	}
+	if context < 0 {
+		context = 0
+	}
+
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf("--- %s\n", fromName))
+	out.WriteString(fmt.Sprintf("+++ %s\n", toName))
+
+	// Line numbers (0-based) in a and b at the start of each edit
+	aLine := make([]int, len(edits)+1)
+	bLine := make([]int, len(edits)+1)
+	for i, edit := range edits {
+		aLine[i+1], bLine[i+1] = aLine[i], bLine[i]
+		if edit.Op != Insert {
+			aLine[i+1]++
+		}
+		if edit.Op != Delete {
+			bLine[i+1]++
+		}
+	}
+
+	i := 0
+	for i < len(edits) {
+		// Find the next change
+		for i < len(edits) && edits[i].Op == Equal {
+			i++
+		}
+		if i == len(edits) {
+			break
+		}
+
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+
+		// Extend the hunk while changes are closer than twice the context
+		end := i
+		for end < len(edits) {
+			if edits[end].Op != Equal {
+				end++
+				continue
+			}
+			run := end
+			for run < len(edits) && edits[run].Op == Equal {
+				run++
+			}
+			if run == len(edits) || run-end > 2*context {
+				end += context
+				if end > run {
+					end = run
+				}
+				break
+			}
+			end = run
+		}
+		if end > len(edits) {
+			end = len(edits)
+		}
+
+		out.WriteString(fmt.Sprintf("@@ -%s +%s @@\n",
+			hunkRange(aLine[start], aLine[end]-aLine[start]),
+			hunkRange(bLine[start], bLine[end]-bLine[start]),
+		))
+		for _, edit := range edits[start:end] {
+			switch edit.Op {
+			case Equal:
+				out.WriteString(" " + edit.Text + "\n")
+			case Delete:
+				out.WriteString("-" + edit.Text + "\n")
+			case Insert:
+				out.WriteString("+" + edit.Text + "\n")
+			}
+		}
+		i = end
+	}
+
+	return out.String()
+}
+
+// hunkRange formats the line range of a hunk header
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// Words renders a word-level diff of a and b. Removed text is wrapped in
+// [-...-] and added text in {+...+}, matching git's --word-diff output.
+// It returns an empty string when both inputs are identical.
+func Words(a, b string) string {
+	edits := Compute(SplitWords(a), SplitWords(b))
+	if !HasChanges(edits) {
+		return ""
+	}
+
+	var out strings.Builder
+	var pending strings.Builder
+	pendingOp := Equal
+
+	flush := func() {
+		if pending.Len() == 0 {
+			return
+		}
+		switch pendingOp {
+		case Delete:
+			out.WriteString("[-" + pending.String() + "-]")
+		case Insert:
+			out.WriteString("{+" + pending.String() + "+}")
+		default:
+			out.WriteString(pending.String())
+		}
+		pending.Reset()
+	}
+
+	for _, edit := range edits {
+		if edit.Op != pendingOp {
+			flush()
+			pendingOp = edit.Op
+		}
+		pending.WriteString(edit.Text)
+	}
+	flush()
+
+	return out.String()
+}
@@ -3,10 +3,12 @@ package formatting
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"text/tabwriter"
 
 	"markcli/internal/logging"
+	"markcli/internal/markdown"
 	"markcli/internal/types/atlassian"
 	"markcli/internal/util"
 )
@@ -18,7 +20,8 @@ type AtlassianConfluenceSpaceTableFormatter struct {
 
 // AtlassianConfluenceSearchResultsFormatter formats search results into markdown
 type AtlassianConfluenceSearchResultsFormatter struct {
-	results []atlassian.AtlassianConfluenceContentResult
+	results    []atlassian.AtlassianConfluenceContentResult
+	showLabels bool
 }
 
 // AtlassianConfluencePageDetailsFormatter formats a single Confluence page's details
@@ -40,6 +43,12 @@ func AtlassianConfluenceCreateSearchResultsFormatter(results []atlassian.Atlassi
 	}
 }
 
+// WithLabels includes the labels of each result in the output
+func (f *AtlassianConfluenceSearchResultsFormatter) WithLabels(show bool) *AtlassianConfluenceSearchResultsFormatter {
+	f.showLabels = show
+	return f
+}
+
 // AtlassianConfluenceCreatePageDetailsFormatter creates a new PageDetailsFormatter
 func AtlassianConfluenceCreatePageDetailsFormatter(page atlassian.AtlassianConfluencePageDetails) *AtlassianConfluencePageDetailsFormatter {
 	return &AtlassianConfluencePageDetailsFormatter{
@@ -89,9 +98,20 @@ func (f *AtlassianConfluenceSearchResultsFormatter) AtlassianConfluenceFormatSea
 
 		// Title and metadata
 		md.WriteString(fmt.Sprintf("Title: %s\n", AtlassianConfluenceCleanTitle(result.Title)))
+		if result.Content.Type != "" && result.Content.Type != "page" {
+			md.WriteString(fmt.Sprintf("Type: %s\n", result.Content.Type))
+		}
 		md.WriteString(fmt.Sprintf("Space: %s\n", result.ResultGlobalContainer.Title))
 		md.WriteString(fmt.Sprintf("Status: %s\n", result.Content.Status))
 		md.WriteString(fmt.Sprintf("Last Modified: %s\n", result.FriendlyLastModified))
+		if f.showLabels {
+			labels := atlassian.AtlassianConfluenceLabelNames(result.Content.Metadata.Labels.Results)
+			if len(labels) == 0 {
+				md.WriteString("Labels: (none)\n")
+			} else {
+				md.WriteString(fmt.Sprintf("Labels: %s\n", strings.Join(labels, ", ")))
+			}
+		}
 		md.WriteString(fmt.Sprintf("URL: %s\n", AtlassianConfluenceFormatURL(result.URL)))
 		md.WriteString("\n")
 
@@ -135,9 +155,16 @@ func (f *AtlassianConfluencePageDetailsFormatter) AtlassianConfluenceFormatPageD
 
 	// Print page metadata
 	output.WriteString(fmt.Sprintf("# %s\n\n", f.page.Title))
-	output.WriteString("**Page Information**\n")
+	if f.page.Type == "blogpost" {
+		output.WriteString("**Blog Post Information**\n")
+	} else {
+		output.WriteString("**Page Information**\n")
+	}
 	output.WriteString(fmt.Sprintf("- **ID**: %s\n", f.page.ID))
 	output.WriteString(fmt.Sprintf("- **Status**: %s\n", f.page.Status))
+	if f.page.Type == "blogpost" && !f.page.CreatedAt.IsZero() {
+		output.WriteString(fmt.Sprintf("- **Published**: %s\n", f.page.CreatedAt.Format("Jan 02, 2006 15:04:05")))
+	}
 	output.WriteString(fmt.Sprintf("- **Version**: %d\n", f.page.Version.Number))
 	if !f.page.Version.CreatedAt.IsZero() {
 		output.WriteString(fmt.Sprintf("- **Last Modified**: %s\n", f.page.Version.CreatedAt.Format("Jan 02, 2006 15:04:05")))
@@ -169,35 +196,746 @@ func (f *AtlassianConfluencePageDetailsFormatter) AtlassianConfluenceFormatPageD
 	}
 
 	// Add comments if available
-	if f.page.Comments != nil && len(f.page.Comments.Results) > 0 {
-		output.WriteString("\n---\n\n## Comments\n\n")
-		for _, comment := range f.page.Comments.Results {
-			output.WriteString(fmt.Sprintf("### %s\n", comment.Title))
-			if comment.Version.Author.DisplayName != "" {
-				output.WriteString(fmt.Sprintf("**Author**: %s\n", comment.Version.Author.DisplayName))
+	if len(f.page.FooterComments) > 0 || len(f.page.InlineComments) > 0 {
+		output.WriteString("\n---\n\n")
+		formatter := AtlassianConfluenceCreateCommentsFormatter(f.page.FooterComments, f.page.InlineComments)
+		output.WriteString(formatter.AtlassianConfluenceFormatCommentsAsMarkdown())
+	}
+
+	return output.String()
+}
+
+// AtlassianConfluenceCommentsFormatter formats the comment threads of a page
+type AtlassianConfluenceCommentsFormatter struct {
+	footer []atlassian.AtlassianConfluenceComment
+	inline []atlassian.AtlassianConfluenceComment
+}
+
+// AtlassianConfluenceCreateCommentsFormatter creates a new comments formatter
+func AtlassianConfluenceCreateCommentsFormatter(footer, inline []atlassian.AtlassianConfluenceComment) *AtlassianConfluenceCommentsFormatter {
+	return &AtlassianConfluenceCommentsFormatter{
+		footer: footer,
+		inline: inline,
+	}
+}
+
+// AtlassianConfluenceFormatCommentsAsMarkdown returns the footer and inline comment threads as markdown
+func (f *AtlassianConfluenceCommentsFormatter) AtlassianConfluenceFormatCommentsAsMarkdown() string {
+	var output strings.Builder
+
+	if len(f.footer) == 0 && len(f.inline) == 0 {
+		return "No comments found.\n"
+	}
+
+	if len(f.footer) > 0 {
+		output.WriteString("## Comments\n\n")
+		for _, comment := range f.footer {
+			writeConfluenceComment(&output, comment, 0)
+			output.WriteString("---\n\n")
+		}
+	}
+
+	if len(f.inline) > 0 {
+		output.WriteString("## Inline Comments\n\n")
+		for _, comment := range f.inline {
+			if selection := comment.Properties.InlineOriginalSelection; selection != "" {
+				output.WriteString(fmt.Sprintf("On: _\"%s\"_\n\n", strings.TrimSpace(selection)))
+			}
+			writeConfluenceComment(&output, comment, 0)
+			output.WriteString("---\n\n")
+		}
+	}
+
+	if truncated := countTruncatedComments(f.footer) + countTruncatedComments(f.inline); truncated > 0 {
+		output.WriteString(fmt.Sprintf("_Replies to %d comments are not shown: the thread is too deep or too long to load._\n", truncated))
+	}
+
+	return output.String()
+}
+
+// countTruncatedComments counts the comments, including replies, whose replies were not loaded
+func countTruncatedComments(comments []atlassian.AtlassianConfluenceComment) int {
+	count := 0
+	for _, comment := range comments {
+		if comment.RepliesTruncated {
+			count++
+		}
+		count += countTruncatedComments(comment.Replies)
+	}
+	return count
+}
+
+// writeConfluenceComment writes a comment and its replies, quoting replies one level deeper
+func writeConfluenceComment(output *strings.Builder, comment atlassian.AtlassianConfluenceComment, depth int) {
+	var entry strings.Builder
+
+	header := fmt.Sprintf("**%s**", commentAuthor(comment))
+	if !comment.Version.CreatedAt.IsZero() {
+		header += fmt.Sprintf(" on %s", comment.Version.CreatedAt.Format("Jan 02, 2006 15:04:05"))
+	}
+	if comment.ResolutionStatus != "" {
+		header += fmt.Sprintf(" [%s]", comment.ResolutionStatus)
+	}
+	entry.WriteString(fmt.Sprintf("%s (ID: %s)\n\n", header, comment.ID))
+
+	if comment.Body.AtlasDocFormat.Value != "" {
+		logging.LogDebug("Converting comment ADF content: %s", comment.Body.AtlasDocFormat.Value)
+		md, err := atlassian.AtlassianDocumentConvertJSONToMarkdown(comment.Body.AtlasDocFormat.Value)
+		if err != nil {
+			entry.WriteString(fmt.Sprintf("Error converting comment to markdown: %v\n\n", err))
+			logging.LogDebug("Comment ADF conversion error: %v", err)
+		} else {
+			entry.WriteString(strings.TrimSpace(md))
+			entry.WriteString("\n\n")
+		}
+	}
+
+	// Quote the entry once per nesting level
+	text := entry.String()
+	if depth > 0 {
+		prefix := strings.Repeat("> ", depth)
+		lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(prefix+line, " ")
+		}
+		text = strings.Join(lines, "\n") + "\n\n"
+	}
+	output.WriteString(text)
+
+	for _, reply := range comment.Replies {
+		writeConfluenceComment(output, reply, depth+1)
+	}
+	if comment.RepliesTruncated {
+		output.WriteString(fmt.Sprintf("%s_Replies not shown_\n\n", strings.Repeat("> ", depth+1)))
+	}
+}
+
+// commentAuthor returns the best available name for the author of a comment
+func commentAuthor(comment atlassian.AtlassianConfluenceComment) string {
+	if comment.Version.Author.DisplayName != "" {
+		return comment.Version.Author.DisplayName
+	}
+	if comment.Version.AuthorID != "" {
+		return comment.Version.AuthorID
+	}
+	return "Unknown"
+}
+
+// AtlassianConfluenceLabelsFormatter formats the labels of a page
+type AtlassianConfluenceLabelsFormatter struct {
+	title  string
+	labels []atlassian.AtlassianConfluenceLabel
+}
+
+// AtlassianConfluenceCreateLabelsFormatter creates a new labels formatter
+func AtlassianConfluenceCreateLabelsFormatter(title string, labels []atlassian.AtlassianConfluenceLabel) *AtlassianConfluenceLabelsFormatter {
+	return &AtlassianConfluenceLabelsFormatter{
+		title:  title,
+		labels: labels,
+	}
+}
+
+// AtlassianConfluenceFormatLabelsAsMarkdown returns the labels as a markdown list
+func (f *AtlassianConfluenceLabelsFormatter) AtlassianConfluenceFormatLabelsAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# Labels on %s\n\n", f.title))
+
+	if len(f.labels) == 0 {
+		output.WriteString("No labels found.\n")
+		return output.String()
+	}
+
+	for _, label := range f.labels {
+		if label.Prefix != "" && label.Prefix != "global" {
+			output.WriteString(fmt.Sprintf("- %s (%s)\n", label.Name, label.Prefix))
+		} else {
+			output.WriteString(fmt.Sprintf("- %s\n", label.Name))
+		}
+	}
+	return output.String()
+}
+
+// AtlassianConfluenceAttachmentsFormatter formats the attachments of a page as a markdown table
+type AtlassianConfluenceAttachmentsFormatter struct {
+	title       string
+	baseURL     string
+	attachments []atlassian.AtlassianConfluenceAttachment
+}
+
+// AtlassianConfluenceCreateAttachmentsFormatter creates a new attachments formatter
+func AtlassianConfluenceCreateAttachmentsFormatter(title, baseURL string, attachments []atlassian.AtlassianConfluenceAttachment) *AtlassianConfluenceAttachmentsFormatter {
+	return &AtlassianConfluenceAttachmentsFormatter{
+		title:       title,
+		baseURL:     baseURL,
+		attachments: attachments,
+	}
+}
+
+// AtlassianConfluenceFormatAttachmentsAsMarkdown returns the attachments as a markdown table
+func (f *AtlassianConfluenceAttachmentsFormatter) AtlassianConfluenceFormatAttachmentsAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# Attachments on %s\n\n", f.title))
+
+	if len(f.attachments) == 0 {
+		output.WriteString("No attachments found.\n")
+		return output.String()
+	}
+
+	output.WriteString("| Name | Type | Size | Version | Modified | ID |\n")
+	output.WriteString("|------|------|------|---------|----------|----|\n")
+	for _, attachment := range f.attachments {
+		name := AtlassianConfluenceEscapeTableCell(attachment.Title)
+		if attachment.WebUILink != "" {
+			name = fmt.Sprintf("[%s](%s/wiki%s)", name, f.baseURL, attachment.WebUILink)
+		}
+		modified := ""
+		if !attachment.CreatedAt.IsZero() {
+			modified = attachment.CreatedAt.Format("Jan 02, 2006 15:04:05")
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %d | %s | %s |\n",
+			name,
+			attachment.MediaType,
+			util.FormatBytes(attachment.FileSize),
+			attachment.Version.Number,
+			modified,
+			attachment.ID,
+		))
+	}
+
+	output.WriteString(fmt.Sprintf("\nShowing %d attachments\n", len(f.attachments)))
+	return output.String()
+}
+
+// AtlassianConfluencePageTreeFormatter formats a page and its descendants as a nested list
+type AtlassianConfluencePageTreeFormatter struct {
+	root atlassian.AtlassianConfluencePageNode
+}
+
+// AtlassianConfluenceCreatePageTreeFormatter creates a new page tree formatter
+func AtlassianConfluenceCreatePageTreeFormatter(root atlassian.AtlassianConfluencePageNode) *AtlassianConfluencePageTreeFormatter {
+	return &AtlassianConfluencePageTreeFormatter{
+		root: root,
+	}
+}
+
+// AtlassianConfluenceFormatPageTreeAsMarkdown returns the page tree as a nested markdown list
+func (f *AtlassianConfluencePageTreeFormatter) AtlassianConfluenceFormatPageTreeAsMarkdown() string {
+	var output strings.Builder
+	writePageTreeNode(&output, f.root, 0)
+	return output.String()
+}
+
+// writePageTreeNode writes a node and its children, indenting each level
+func writePageTreeNode(output *strings.Builder, node atlassian.AtlassianConfluencePageNode, depth int) {
+	output.WriteString(fmt.Sprintf("%s- %s (ID: %s)\n", strings.Repeat("  ", depth), node.Title, node.ID))
+	for _, child := range node.Children {
+		writePageTreeNode(output, child, depth+1)
+	}
+}
+
+// AtlassianConfluenceTemplatesFormatter formats page templates and blueprints as a markdown table
+type AtlassianConfluenceTemplatesFormatter struct {
+	templates []atlassian.AtlassianConfluenceTemplate
+}
+
+// AtlassianConfluenceCreateTemplatesFormatter creates a new templates formatter
+func AtlassianConfluenceCreateTemplatesFormatter(templates []atlassian.AtlassianConfluenceTemplate) *AtlassianConfluenceTemplatesFormatter {
+	return &AtlassianConfluenceTemplatesFormatter{
+		templates: templates,
+	}
+}
+
+// AtlassianConfluenceFormatTemplatesAsMarkdown returns the templates as a markdown table
+func (f *AtlassianConfluenceTemplatesFormatter) AtlassianConfluenceFormatTemplatesAsMarkdown() string {
+	if len(f.templates) == 0 {
+		return "No templates found.\n"
+	}
+
+	var output strings.Builder
+	output.WriteString("| Name | Type | Space | Labels | ID | Description |\n")
+	output.WriteString("|------|------|-------|--------|----|-------------|\n")
+	for _, template := range f.templates {
+		space := "(global)"
+		if template.Space != nil && template.Space.Key != "" {
+			space = template.Space.Key
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s |\n",
+			AtlassianConfluenceEscapeTableCell(template.Name),
+			template.TemplateType,
+			space,
+			strings.Join(atlassian.AtlassianConfluenceLabelNames(template.Labels), ", "),
+			template.TemplateID,
+			AtlassianConfluenceEscapeTableCell(util.TruncateText(template.Description, 80)),
+		))
+	}
+
+	output.WriteString(fmt.Sprintf("\nShowing %d templates\n", len(f.templates)))
+	return output.String()
+}
+
+// AtlassianConfluenceBlogPostsFormatter formats the blog posts of a space as a markdown table
+type AtlassianConfluenceBlogPostsFormatter struct {
+	baseURL string
+	posts   []atlassian.AtlassianConfluencePageDetails
+	authors map[string]string
+}
+
+// AtlassianConfluenceCreateBlogPostsFormatter creates a new blog posts formatter.
+// Authors maps account IDs to display names.
+func AtlassianConfluenceCreateBlogPostsFormatter(baseURL string, posts []atlassian.AtlassianConfluencePageDetails, authors map[string]string) *AtlassianConfluenceBlogPostsFormatter {
+	return &AtlassianConfluenceBlogPostsFormatter{
+		baseURL: baseURL,
+		posts:   posts,
+		authors: authors,
+	}
+}
+
+// AtlassianConfluenceFormatBlogPostsAsMarkdown returns the blog posts as a markdown table
+func (f *AtlassianConfluenceBlogPostsFormatter) AtlassianConfluenceFormatBlogPostsAsMarkdown() string {
+	if len(f.posts) == 0 {
+		return "No blog posts found.\n"
+	}
+
+	var output strings.Builder
+	output.WriteString("| Title | Published | Author | Status | ID |\n")
+	output.WriteString("|-------|-----------|--------|--------|----|\n")
+	for _, post := range f.posts {
+		title := AtlassianConfluenceEscapeTableCell(post.Title)
+		if post.Links.WebUI != "" {
+			title = fmt.Sprintf("[%s](%s/wiki%s)", title, f.baseURL, post.Links.WebUI)
+		}
+		published := ""
+		if !post.CreatedAt.IsZero() {
+			published = post.CreatedAt.Format("Jan 02, 2006")
+		}
+		author := f.authors[post.AuthorID]
+		if author == "" {
+			author = post.AuthorID
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n", title, published, author, post.Status, post.ID))
+	}
+
+	output.WriteString(fmt.Sprintf("\nShowing %d blog posts\n", len(f.posts)))
+	return output.String()
+}
+
+// AtlassianConfluenceContentTableFormatter formats search results as a compact table,
+// used for content types without a body such as whiteboards, databases, and folders
+type AtlassianConfluenceContentTableFormatter struct {
+	baseURL string
+	results []atlassian.AtlassianConfluenceContentResult
+}
+
+// AtlassianConfluenceCreateContentTableFormatter creates a new content table formatter
+func AtlassianConfluenceCreateContentTableFormatter(baseURL string, results []atlassian.AtlassianConfluenceContentResult) *AtlassianConfluenceContentTableFormatter {
+	return &AtlassianConfluenceContentTableFormatter{
+		baseURL: baseURL,
+		results: results,
+	}
+}
+
+// AtlassianConfluenceFormatContentAsMarkdown returns the content as a markdown table
+func (f *AtlassianConfluenceContentTableFormatter) AtlassianConfluenceFormatContentAsMarkdown() string {
+	if len(f.results) == 0 {
+		return "No content found.\n"
+	}
+
+	var output strings.Builder
+	output.WriteString("| Title | Type | Space | Last Modified | ID |\n")
+	output.WriteString("|-------|------|-------|---------------|----|\n")
+	for _, result := range f.results {
+		title := AtlassianConfluenceEscapeTableCell(AtlassianConfluenceCleanTitle(result.Title))
+		if result.URL != "" {
+			title = fmt.Sprintf("[%s](%s/wiki%s)", title, f.baseURL, result.URL)
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+			title,
+			result.Content.Type,
+			AtlassianConfluenceEscapeTableCell(result.ResultGlobalContainer.Title),
+			result.FriendlyLastModified,
+			result.Content.ID,
+		))
+	}
+	return output.String()
+}
+
+// AtlassianConfluenceSpaceDetailsFormatter formats the details of a single space
+type AtlassianConfluenceSpaceDetailsFormatter struct {
+	baseURL string
+	summary atlassian.AtlassianConfluenceSpaceSummary
+}
+
+// AtlassianConfluenceCreateSpaceDetailsFormatter creates a new space details formatter
+func AtlassianConfluenceCreateSpaceDetailsFormatter(baseURL string, summary atlassian.AtlassianConfluenceSpaceSummary) *AtlassianConfluenceSpaceDetailsFormatter {
+	return &AtlassianConfluenceSpaceDetailsFormatter{
+		baseURL: baseURL,
+		summary: summary,
+	}
+}
+
+// AtlassianConfluenceFormatSpaceDetailsAsMarkdown returns a raw markdown representation of the space
+func (f *AtlassianConfluenceSpaceDetailsFormatter) AtlassianConfluenceFormatSpaceDetailsAsMarkdown() string {
+	space := f.summary.Space
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# %s\n\n", space.Name))
+	output.WriteString("**Space Information**\n")
+	output.WriteString(fmt.Sprintf("- **Key**: %s\n", space.Key))
+	output.WriteString(fmt.Sprintf("- **ID**: %s\n", space.ID))
+	output.WriteString(fmt.Sprintf("- **Type**: %s\n", strings.Title(strings.ToLower(space.Type))))
+	output.WriteString(fmt.Sprintf("- **Status**: %s\n", strings.Title(strings.ToLower(space.Status))))
+	if f.summary.OwnerName != "" {
+		output.WriteString(fmt.Sprintf("- **Owner**: %s\n", f.summary.OwnerName))
+	}
+	if !space.CreatedAt.IsZero() {
+		output.WriteString(fmt.Sprintf("- **Created**: %s\n", space.CreatedAt.Format("Jan 02, 2006")))
+	}
+	if space.HomepageID != "" {
+		title := f.summary.HomepageTitle
+		if title == "" {
+			title = space.HomepageID
+		}
+		output.WriteString(fmt.Sprintf("- **Homepage**: %s (ID: %s)\n", title, space.HomepageID))
+	}
+	if space.Links.WebUI != "" {
+		output.WriteString(fmt.Sprintf("- **Web URL**: %s/wiki%s\n", f.baseURL, space.Links.WebUI))
+	}
+	if len(f.summary.Labels) > 0 {
+		names := make([]string, 0, len(f.summary.Labels))
+		for _, label := range f.summary.Labels {
+			names = append(names, label.Name)
+		}
+		output.WriteString(fmt.Sprintf("- **Labels**: %s\n", strings.Join(names, ", ")))
+	}
+
+	output.WriteString("\n## Description\n\n")
+	if description := strings.TrimSpace(space.Description.Plain.Value); description != "" {
+		output.WriteString(description + "\n")
+	} else {
+		output.WriteString("*No description*\n")
+	}
+
+	output.WriteString("\n## Content Labels\n\n")
+	if len(f.summary.ContentLabels) == 0 {
+		output.WriteString("*No labels are used in this space*\n")
+		return output.String()
+	}
+	output.WriteString("| Label | Items |\n")
+	output.WriteString("|-------|-------|\n")
+	for _, label := range f.summary.ContentLabels {
+		output.WriteString(fmt.Sprintf("| %s | %d |\n", AtlassianConfluenceEscapeTableCell(label.Name), label.Count))
+	}
+
+	return output.String()
+}
+
+// AtlassianConfluenceSpacePermissionsFormatter formats space permissions as a matrix
+// of principals against operations
+type AtlassianConfluenceSpacePermissionsFormatter struct {
+	permissions []atlassian.AtlassianConfluenceSpacePermission
+	names       map[string]string
+}
+
+// AtlassianConfluenceCreateSpacePermissionsFormatter creates a new space permissions formatter.
+// Names maps principal IDs to display names.
+func AtlassianConfluenceCreateSpacePermissionsFormatter(permissions []atlassian.AtlassianConfluenceSpacePermission, names map[string]string) *AtlassianConfluenceSpacePermissionsFormatter {
+	return &AtlassianConfluenceSpacePermissionsFormatter{
+		permissions: permissions,
+		names:       names,
+	}
+}
+
+// Column order of the permission matrix; unknown operations are appended alphabetically
+var (
+	spacePermissionTargetOrder = []string{"space", "page", "blogpost", "comment", "attachment"}
+	spacePermissionKeyOrder    = []string{"read", "create", "delete", "archive", "export", "restrict_content", "administer"}
+)
+
+// AtlassianConfluenceFormatSpacePermissionsAsMarkdown returns the permission matrix as a markdown table
+func (f *AtlassianConfluenceSpacePermissionsFormatter) AtlassianConfluenceFormatSpacePermissionsAsMarkdown() string {
+	if len(f.permissions) == 0 {
+		return "No permissions found.\n"
+	}
+
+	type principal struct {
+		kind, id string
+	}
+	operation := func(p atlassian.AtlassianConfluenceSpacePermission) string {
+		return p.Operation.Key + " " + p.Operation.TargetType
+	}
+
+	// Collect the distinct principals and operations, and who holds what
+	var principals []principal
+	var operations []string
+	seen := make(map[string]bool)
+	granted := make(map[principal]map[string]bool)
+	for _, permission := range f.permissions {
+		p := principal{permission.Principal.Type, permission.Principal.ID}
+		if granted[p] == nil {
+			granted[p] = make(map[string]bool)
+			principals = append(principals, p)
+		}
+		op := operation(permission)
+		if !seen[op] {
+			seen[op] = true
+			operations = append(operations, op)
+		}
+		granted[p][op] = true
+	}
+
+	rank := func(list []string, value string) int {
+		for i, v := range list {
+			if v == value {
+				return i
 			}
-			if !comment.Version.CreatedAt.IsZero() {
-				output.WriteString(fmt.Sprintf("**Last Modified**: %s\n\n", comment.Version.CreatedAt.Format("Jan 02, 2006 15:04:05")))
+		}
+		return len(list)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		ki, ti, _ := strings.Cut(operations[i], " ")
+		kj, tj, _ := strings.Cut(operations[j], " ")
+		if a, b := rank(spacePermissionTargetOrder, ti), rank(spacePermissionTargetOrder, tj); a != b {
+			return a < b
+		}
+		if a, b := rank(spacePermissionKeyOrder, ki), rank(spacePermissionKeyOrder, kj); a != b {
+			return a < b
+		}
+		return operations[i] < operations[j]
+	})
+
+	name := func(p principal) string {
+		if n := f.names[p.id]; n != "" {
+			return n
+		}
+		return p.id
+	}
+	kinds := []string{"group", "role", "user"}
+	sort.SliceStable(principals, func(i, j int) bool {
+		if principals[i].kind != principals[j].kind {
+			return rank(kinds, principals[i].kind) < rank(kinds, principals[j].kind)
+		}
+		return strings.ToLower(name(principals[i])) < strings.ToLower(name(principals[j]))
+	})
+
+	var output strings.Builder
+	output.WriteString("| Principal | Type |")
+	separator := "|-----------|------|"
+	for _, op := range operations {
+		output.WriteString(fmt.Sprintf(" %s |", strings.ReplaceAll(op, "_", " ")))
+		separator += strings.Repeat("-", len(op)+2) + "|"
+	}
+	output.WriteString("\n" + separator + "\n")
+
+	for _, p := range principals {
+		output.WriteString(fmt.Sprintf("| %s | %s |", AtlassianConfluenceEscapeTableCell(name(p)), p.kind))
+		for _, op := range operations {
+			cell := ""
+			if granted[p][op] {
+				cell = "✓"
 			}
+			output.WriteString(fmt.Sprintf(" %s |", cell))
+		}
+		output.WriteString("\n")
+	}
 
-			if comment.Body.AtlasDocFormat.Value != "" {
-				logging.LogDebug("Converting comment ADF content: %s", comment.Body.AtlasDocFormat.Value)
-				md, err := atlassian.AtlassianDocumentConvertJSONToMarkdown(comment.Body.AtlasDocFormat.Value)
-				if err != nil {
-					output.WriteString(fmt.Sprintf("Error converting comment to markdown: %v\n", err))
-					logging.LogDebug("Comment ADF conversion error: %v", err)
-				} else {
-					output.WriteString(md)
-					output.WriteString("\n")
-				}
+	output.WriteString(fmt.Sprintf("\n%d principals, %d permissions\n", len(principals), len(f.permissions)))
+	return output.String()
+}
+
+// AtlassianConfluenceRestrictionsFormatter formats the restrictions of a page and its ancestors,
+// optionally followed by the effective access of a user
+type AtlassianConfluenceRestrictionsFormatter struct {
+	levels []atlassian.AtlassianConfluencePageRestrictions
+	check  *atlassian.AtlassianConfluenceAccessCheck
+}
+
+// AtlassianConfluenceCreateRestrictionsFormatter creates a new restrictions formatter.
+// The first level is the inspected page; the others are its restricted ancestors, top-down.
+func AtlassianConfluenceCreateRestrictionsFormatter(levels []atlassian.AtlassianConfluencePageRestrictions, check *atlassian.AtlassianConfluenceAccessCheck) *AtlassianConfluenceRestrictionsFormatter {
+	return &AtlassianConfluenceRestrictionsFormatter{
+		levels: levels,
+		check:  check,
+	}
+}
+
+// AtlassianConfluenceFormatRestrictionsAsMarkdown returns a raw markdown representation of the restrictions
+func (f *AtlassianConfluenceRestrictionsFormatter) AtlassianConfluenceFormatRestrictionsAsMarkdown() string {
+	if len(f.levels) == 0 {
+		return "No restrictions found.\n"
+	}
+
+	page := f.levels[0]
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# Restrictions: %s\n\n", page.Page.Title))
+	output.WriteString("## This Page\n\n")
+	output.WriteString(fmt.Sprintf("- **View**: %s\n", formatRestriction(page.Read)))
+	output.WriteString(fmt.Sprintf("- **Edit**: %s\n", formatRestriction(page.Update)))
+
+	output.WriteString("\n## Inherited from Ancestors\n\n")
+	if len(f.levels) == 1 {
+		output.WriteString("*No ancestor restricts viewing*\n")
+	} else {
+		output.WriteString("Only people allowed by every level below can view this page. Edit restrictions are not inherited.\n\n")
+		output.WriteString("| Page | ID | View Restricted To |\n")
+		output.WriteString("|------|----|--------------------|\n")
+		for _, level := range f.levels[1:] {
+			output.WriteString(fmt.Sprintf("| %s | %s | %s |\n",
+				AtlassianConfluenceEscapeTableCell(level.Page.Title),
+				level.Page.ID,
+				AtlassianConfluenceEscapeTableCell(formatRestriction(level.Read)),
+			))
+		}
+	}
+
+	if f.check != nil {
+		name := f.check.User.DisplayName
+		if name == "" {
+			name = f.check.User.AccountID
+		}
+		output.WriteString(fmt.Sprintf("\n## Effective Access: %s\n\n", name))
+		output.WriteString(fmt.Sprintf("- **Can view**: %s\n", yesNo(f.check.CanView)))
+		output.WriteString(fmt.Sprintf("- **Can edit**: %s\n", yesNo(f.check.CanEdit)))
+		if len(f.check.Reasons) > 0 {
+			output.WriteString("\n")
+			for _, reason := range f.check.Reasons {
+				output.WriteString(fmt.Sprintf("- %s\n", reason))
 			}
-			output.WriteString("\n---\n\n")
 		}
 	}
 
 	return output.String()
 }
 
+// formatRestriction lists the users and groups of a restriction
+func formatRestriction(restriction atlassian.AtlassianConfluenceRestriction) string {
+	if restriction.Empty() {
+		return "Not restricted"
+	}
+
+	var parts []string
+	if users := restriction.Restrictions.User.Results; len(users) > 0 {
+		names := make([]string, 0, len(users))
+		for _, user := range users {
+			names = append(names, user.DisplayName)
+		}
+		parts = append(parts, "users "+strings.Join(names, ", "))
+	}
+	if groups := restriction.Restrictions.Group.Results; len(groups) > 0 {
+		names := make([]string, 0, len(groups))
+		for _, group := range groups {
+			names = append(names, group.Name)
+		}
+		parts = append(parts, "groups "+strings.Join(names, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// yesNo renders a boolean for humans
+func yesNo(value bool) string {
+	if value {
+		return "Yes"
+	}
+	return "No"
+}
+
+// AtlassianConfluenceTasksFormatter formats inline tasks as a markdown table
+type AtlassianConfluenceTasksFormatter struct {
+	tasks []atlassian.AtlassianConfluenceTaskContent
+}
+
+// AtlassianConfluenceCreateTasksFormatter creates a new tasks formatter
+func AtlassianConfluenceCreateTasksFormatter(tasks []atlassian.AtlassianConfluenceTaskContent) *AtlassianConfluenceTasksFormatter {
+	return &AtlassianConfluenceTasksFormatter{
+		tasks: tasks,
+	}
+}
+
+// AtlassianConfluenceFormatTasksAsMarkdown returns the tasks as a markdown table
+func (f *AtlassianConfluenceTasksFormatter) AtlassianConfluenceFormatTasksAsMarkdown() string {
+	if len(f.tasks) == 0 {
+		return "No tasks found.\n"
+	}
+
+	var output strings.Builder
+	output.WriteString("| Task | Status | Assignee | Due | Page | ID |\n")
+	output.WriteString("|------|--------|----------|-----|------|----|\n")
+	for _, item := range f.tasks {
+		status := "☐ Open"
+		if item.Task.Status == "complete" {
+			status = "☑ Done"
+		}
+		due := ""
+		if !item.Task.DueAt.IsZero() {
+			due = item.Task.DueAt.Format("Jan 02, 2006")
+		}
+		page := AtlassianConfluenceEscapeTableCell(item.ContentTitle)
+		if item.ContentURL != "" {
+			page = fmt.Sprintf("[%s](%s)", page, item.ContentURL)
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s |\n",
+			AtlassianConfluenceEscapeTableCell(AtlassianConfluenceTaskText(item.Task)),
+			status,
+			AtlassianConfluenceEscapeTableCell(item.AssigneeName),
+			due,
+			page,
+			item.Task.ID,
+		))
+	}
+
+	output.WriteString(fmt.Sprintf("\nShowing %d tasks\n", len(f.tasks)))
+	return output.String()
+}
+
+// AtlassianConfluenceTaskText returns the text of a task as a single line of markdown
+func AtlassianConfluenceTaskText(task atlassian.AtlassianConfluenceTask) string {
+	value := task.Body.AtlasDocFormat.Value
+	if value == "" {
+		return ""
+	}
+
+	text, err := atlassian.AtlassianDocumentConvertJSONToMarkdown(value)
+	if err != nil {
+		logging.LogDebug("Failed to convert task %s: %v", task.ID, err)
+		return ""
+	}
+
+	// The body is a single task item, rendered as a checklist entry
+	text = strings.TrimSpace(text)
+	for _, prefix := range []string{"- [ ] ", "- [x] ", "- [X] "} {
+		text = strings.TrimPrefix(text, prefix)
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// AtlassianConfluencePageExportFormatter formats a page as markdown with YAML frontmatter
+type AtlassianConfluencePageExportFormatter struct {
+	page        atlassian.AtlassianConfluencePageDetails
+	frontmatter atlassian.AtlassianConfluencePageFrontmatter
+}
+
+// AtlassianConfluenceCreatePageExportFormatter creates a new page export formatter
+func AtlassianConfluenceCreatePageExportFormatter(page atlassian.AtlassianConfluencePageDetails, frontmatter atlassian.AtlassianConfluencePageFrontmatter) *AtlassianConfluencePageExportFormatter {
+	return &AtlassianConfluencePageExportFormatter{
+		page:        page,
+		frontmatter: frontmatter,
+	}
+}
+
+// AtlassianConfluenceFormatPageAsExport returns the page body as markdown preceded by YAML frontmatter
+func (f *AtlassianConfluencePageExportFormatter) AtlassianConfluenceFormatPageAsExport() (string, error) {
+	var body string
+	if f.page.Body.AtlasDocFormat.Value != "" {
+		md, err := atlassian.AtlassianDocumentConvertJSONToMarkdown(f.page.Body.AtlasDocFormat.Value)
+		if err != nil {
+			return "", fmt.Errorf("failed to convert page to markdown: %w", err)
+		}
+		body = md
+	}
+
+	return markdown.AddFrontmatter(f.frontmatter, fmt.Sprintf("# %s\n\n%s", f.page.Title, body))
+}
+
 // Helper functions
 
 // AtlassianConfluenceCleanTitle replaces highlight markers with bold markers
@@ -261,3 +999,103 @@ func AtlassianConfluenceWrapText(text string, lineLength int) string {
 
 	return strings.Join(lines, "\n")
 }
+
+// AtlassianConfluencePageHistoryFormatter formats a page's version history as a markdown table
+type AtlassianConfluencePageHistoryFormatter struct {
+	title    string
+	versions []atlassian.AtlassianConfluencePageVersion
+}
+
+// AtlassianConfluenceCreatePageHistoryFormatter creates a new page history formatter
+func AtlassianConfluenceCreatePageHistoryFormatter(title string, versions []atlassian.AtlassianConfluencePageVersion) *AtlassianConfluencePageHistoryFormatter {
+	return &AtlassianConfluencePageHistoryFormatter{
+		title:    title,
+		versions: versions,
+	}
+}
+
+// AtlassianConfluenceFormatPageHistoryAsMarkdown returns the version history as a markdown table
+func (f *AtlassianConfluencePageHistoryFormatter) AtlassianConfluenceFormatPageHistoryAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# History of %s\n\n", f.title))
+
+	if len(f.versions) == 0 {
+		output.WriteString("No versions found.\n")
+		return output.String()
+	}
+
+	output.WriteString("| Version | Date | Author | Minor | Message |\n")
+	output.WriteString("|---------|------|--------|-------|---------|\n")
+	for _, version := range f.versions {
+		date := ""
+		if !version.CreatedAt.IsZero() {
+			date = version.CreatedAt.Format("Jan 02, 2006 15:04:05")
+		}
+		minor := ""
+		if version.MinorEdit {
+			minor = "yes"
+		}
+		author := version.Author.DisplayName
+		if author == "" {
+			author = version.AuthorID
+		}
+		output.WriteString(fmt.Sprintf("| %d | %s | %s | %s | %s |\n",
+			version.Number,
+			date,
+			author,
+			minor,
+			AtlassianConfluenceEscapeTableCell(version.Message),
+		))
+	}
+
+	output.WriteString(fmt.Sprintf("\nShowing %d versions\n", len(f.versions)))
+	return output.String()
+}
+
+// AtlassianConfluencePageDiffFormatter formats the difference between two renderings of a page
+type AtlassianConfluencePageDiffFormatter struct {
+	title    string
+	fromName string
+	toName   string
+	diff     string
+	wordDiff bool
+}
+
+// AtlassianConfluenceCreatePageDiffFormatter creates a new page diff formatter.
+// The diff is either a unified diff or, when wordDiff is set, a word-level diff.
+func AtlassianConfluenceCreatePageDiffFormatter(title, fromName, toName, diff string, wordDiff bool) *AtlassianConfluencePageDiffFormatter {
+	return &AtlassianConfluencePageDiffFormatter{
+		title:    title,
+		fromName: fromName,
+		toName:   toName,
+		diff:     diff,
+		wordDiff: wordDiff,
+	}
+}
+
+// AtlassianConfluenceFormatPageDiffAsMarkdown returns the diff wrapped in a fenced code block
+func (f *AtlassianConfluencePageDiffFormatter) AtlassianConfluenceFormatPageDiffAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# Changes to %s\n\n", f.title))
+	output.WriteString(fmt.Sprintf("- **From**: %s\n", f.fromName))
+	output.WriteString(fmt.Sprintf("- **To**: %s\n\n", f.toName))
+
+	if f.diff == "" {
+		output.WriteString("No differences found.\n")
+		return output.String()
+	}
+
+	language := "diff"
+	if f.wordDiff {
+		language = "text"
+	}
+	output.WriteString(util.FencedCodeBlock(language, f.diff))
+	return output.String()
+}
+
+// AtlassianConfluenceEscapeTableCell makes text safe to place inside a markdown table cell
+func AtlassianConfluenceEscapeTableCell(text string) string {
+	text = strings.ReplaceAll(text, "\n", " ")
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.TrimSpace(text)
+}
@@ -2,10 +2,16 @@ package formatting
 
 import (
 	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"markcli/internal/diff"
 	"markcli/internal/types/atlassian"
 	"markcli/internal/util"
 )
@@ -18,13 +24,18 @@ type AtlassianJiraProjectTableFormatter struct {
 
 // AtlassianJiraSearchResultsFormatter formats Jira issue search results as Markdown
 type AtlassianJiraSearchResultsFormatter struct {
-	issues []atlassian.AtlassianJiraIssue
+	issues  []atlassian.AtlassianJiraIssue
+	fields  []atlassian.AtlassianJiraField // Extra fields listed for each issue
+	columns []atlassian.AtlassianJiraField // Table columns; results are listed when empty
 }
 
 // AtlassianJiraIssueDetailsFormatter formats a single Jira issue's details
 type AtlassianJiraIssueDetailsFormatter struct {
-	issue    atlassian.AtlassianJiraIssue
-	comments *atlassian.AtlassianJiraCommentsResponse
+	issue           atlassian.AtlassianJiraIssue
+	comments        *atlassian.AtlassianJiraCommentsResponse
+	fields          []atlassian.AtlassianJiraField
+	showEmpty       bool
+	attachmentLinks map[string]string // Local paths of downloaded attachments, by attachment ID
 }
 
 // AtlassianJiraCreateProjectTableFormatter creates a new project table formatter
@@ -52,6 +63,33 @@ func AtlassianJiraCreateIssueDetailsFormatter(issue atlassian.AtlassianJiraIssue
 	}
 }
 
+// WithFields adds extra fields to the issue information. Fields without a value are
+// shown as "None" when showEmpty is set and left out otherwise.
+func (f *AtlassianJiraIssueDetailsFormatter) WithFields(fields []atlassian.AtlassianJiraField, showEmpty bool) *AtlassianJiraIssueDetailsFormatter {
+	f.fields = fields
+	f.showEmpty = showEmpty
+	return f
+}
+
+// WithAttachmentLinks links attachments, and the screenshots in the description, to
+// local copies instead of Jira. links maps attachment IDs to relative paths.
+func (f *AtlassianJiraIssueDetailsFormatter) WithAttachmentLinks(links map[string]string) *AtlassianJiraIssueDetailsFormatter {
+	f.attachmentLinks = links
+	return f
+}
+
+// WithFields adds extra fields to each search result
+func (f *AtlassianJiraSearchResultsFormatter) WithFields(fields []atlassian.AtlassianJiraField) *AtlassianJiraSearchResultsFormatter {
+	f.fields = fields
+	return f
+}
+
+// WithColumns renders the results as a table with the given columns
+func (f *AtlassianJiraSearchResultsFormatter) WithColumns(columns []atlassian.AtlassianJiraField) *AtlassianJiraSearchResultsFormatter {
+	f.columns = columns
+	return f
+}
+
 // WithComments adds comments to the formatter
 func (f *AtlassianJiraIssueDetailsFormatter) WithComments(comments *atlassian.AtlassianJiraCommentsResponse) *AtlassianJiraIssueDetailsFormatter {
 	f.comments = comments
@@ -91,6 +129,9 @@ func (f *AtlassianJiraSearchResultsFormatter) AtlassianJiraFormatSearchResultsAs
 	if len(f.issues) == 0 {
 		return "No issues found."
 	}
+	if len(f.columns) > 0 {
+		return f.formatSearchResultsAsTable()
+	}
 
 	var output strings.Builder
 
@@ -111,6 +152,19 @@ func (f *AtlassianJiraSearchResultsFormatter) AtlassianJiraFormatSearchResultsAs
 			output.WriteString(fmt.Sprintf("Assignee: %s\n", issue.Fields.Assignee.DisplayName))
 		}
 
+		// Add extra fields
+		for _, field := range f.fields {
+			if jiraStandardFields[field.ID] {
+				continue
+			}
+			if raw, ok := issue.FieldValue(field.ID); ok {
+				if value := AtlassianJiraFormatFieldValue(raw, field.Schema); value != "" {
+					value = strings.Join(strings.Fields(util.TruncateText(value, 200)), " ")
+					output.WriteString(fmt.Sprintf("%s: %s\n", field.Name, value))
+				}
+			}
+		}
+
 		// Add last modified date
 		if issue.Fields.Updated != "" {
 			t, err := util.ParseDate(issue.Fields.Updated)
@@ -147,6 +201,36 @@ func (f *AtlassianJiraSearchResultsFormatter) AtlassianJiraFormatSearchResultsAs
 	return output.String()
 }
 
+// formatSearchResultsAsTable returns the search results as a table of the chosen columns
+func (f *AtlassianJiraSearchResultsFormatter) formatSearchResultsAsTable() string {
+	var output strings.Builder
+
+	header := make([]string, len(f.columns))
+	separator := make([]string, len(f.columns))
+	for i, column := range f.columns {
+		header[i] = column.Name
+		separator[i] = strings.Repeat("-", len(column.Name))
+	}
+	output.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	output.WriteString("|" + strings.Join(separator, "|") + "|\n")
+
+	for _, issue := range f.issues {
+		cells := make([]string, len(f.columns))
+		for i, column := range f.columns {
+			if column.ID == "issuekey" {
+				cells[i] = issue.Key
+				continue
+			}
+			if raw, ok := issue.FieldValue(column.ID); ok {
+				cells[i] = AtlassianConfluenceEscapeTableCell(util.TruncateText(AtlassianJiraFormatFieldValue(raw, column.Schema), 60))
+			}
+		}
+		output.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+
+	return output.String()
+}
+
 // AtlassianJiraFormatIssueDetailsAsMarkdown returns issue details in markdown format
 func (f *AtlassianJiraIssueDetailsFormatter) AtlassianJiraFormatIssueDetailsAsMarkdown() string {
 	issue := f.issue
@@ -195,12 +279,40 @@ func (f *AtlassianJiraIssueDetailsFormatter) AtlassianJiraFormatIssueDetailsAsMa
 		output.WriteString(fmt.Sprintf("- **Resolution**: %s\n", issue.Fields.Resolution.Name))
 	}
 
+	if parent := issue.Fields.Parent; parent != nil {
+		output.WriteString(fmt.Sprintf("- **Parent**: %s %s (%s, %s)\n", parent.Key, parent.Fields.Summary, parent.Fields.IssueType.Name, parent.Fields.Status.Name))
+	}
+
 	// Add URL if available
 	if issue.Self != "" {
 		webURL := strings.Replace(issue.Self, "/rest/api/3/issue/", "/browse/", 1)
 		output.WriteString(fmt.Sprintf("- **Web URL**: %s\n", webURL))
 	}
 
+	// Extra fields; rich text fields get their own section below the description
+	var richText []atlassian.AtlassianJiraField
+	for _, field := range f.fields {
+		if jiraStandardFields[field.ID] {
+			continue
+		}
+		raw, ok := issue.FieldValue(field.ID)
+		if ok && AtlassianJiraFieldIsRichText(raw) {
+			richText = append(richText, field)
+			continue
+		}
+		value := ""
+		if ok {
+			value = AtlassianJiraFormatFieldValue(raw, field.Schema)
+		}
+		if value == "" {
+			if !f.showEmpty {
+				continue
+			}
+			value = "None"
+		}
+		output.WriteString(fmt.Sprintf("- **%s**: %s\n", field.Name, value))
+	}
+
 	output.WriteString("\n---\n\n")
 
 	// Description
@@ -210,6 +322,8 @@ func (f *AtlassianJiraIssueDetailsFormatter) AtlassianJiraFormatIssueDetailsAsMa
 			Content: issue.Fields.Description.Content,
 			Version: issue.Fields.Description.Version,
 		}
+		// Embedded screenshots live in the media store; link them to the attachments instead
+		doc.AtlassianDocumentResolveMedia(jiraAttachmentURL(issue.Fields.Attachments, f.attachmentLinks))
 		if desc, err := doc.AtlassianDocumentConvertToMarkdown(); err == nil {
 			output.WriteString("## Description\n\n")
 			output.WriteString(desc)
@@ -217,40 +331,1082 @@ func (f *AtlassianJiraIssueDetailsFormatter) AtlassianJiraFormatIssueDetailsAsMa
 		}
 	}
 
+	for _, field := range richText {
+		raw, _ := issue.FieldValue(field.ID)
+		if value := AtlassianJiraFormatFieldValue(raw, field.Schema); value != "" {
+			output.WriteString(fmt.Sprintf("## %s\n\n%s\n\n", field.Name, value))
+		}
+	}
+
+	if len(issue.Fields.Subtasks) > 0 {
+		output.WriteString("## Subtasks\n\n")
+		for _, subtask := range issue.Fields.Subtasks {
+			writeLinkedIssue(&output, subtask)
+		}
+		output.WriteString("\n")
+	}
+
+	// Linked issues, grouped by how this issue relates to them
+	if len(issue.Fields.IssueLinks) > 0 {
+		output.WriteString("## Linked Issues\n\n")
+		var relations []string
+		grouped := make(map[string][]atlassian.AtlassianJiraLinkedIssue)
+		for _, link := range issue.Fields.IssueLinks {
+			relation, other := link.Relation()
+			if other == nil {
+				continue
+			}
+			if _, ok := grouped[relation]; !ok {
+				relations = append(relations, relation)
+			}
+			grouped[relation] = append(grouped[relation], *other)
+		}
+		for _, relation := range relations {
+			output.WriteString(fmt.Sprintf("### %s\n\n", relation))
+			for _, other := range grouped[relation] {
+				writeLinkedIssue(&output, other)
+			}
+			output.WriteString("\n")
+		}
+	}
+
+	if len(issue.Fields.Attachments) > 0 {
+		output.WriteString("## Attachments\n\n")
+		writeJiraAttachmentsTable(&output, issue.Fields.Attachments, f.attachmentLinks)
+		output.WriteString("\n")
+	}
+
 	// Comments
 	if f.comments != nil && len(f.comments.Comments) > 0 {
 		output.WriteString("## Comments\n\n")
 		for _, comment := range f.comments.Comments {
-			if comment.Author != nil {
-				output.WriteString(fmt.Sprintf("**%s** ", comment.Author.DisplayName))
-			}
-			if comment.Created != "" {
-				t, err := util.ParseDate(comment.Created)
-				if err == nil {
-					output.WriteString(fmt.Sprintf("on %s", t.Format("Jan 02, 2006 15:04:05")))
-				} else {
-					output.WriteString(fmt.Sprintf("on %s", comment.Created))
-				}
+			writeJiraComment(&output, comment)
+		}
+	} else {
+		output.WriteString("## Comments\n\nNo comments found\n")
+	}
+
+	return output.String()
+}
+
+// writeLinkedIssue writes a subtask or linked issue as a list item with its status
+func writeLinkedIssue(output *strings.Builder, issue atlassian.AtlassianJiraLinkedIssue) {
+	output.WriteString(fmt.Sprintf("- **%s** %s — %s\n", issue.Key, issue.Fields.Summary, issue.Fields.Status.Name))
+}
+
+// AtlassianJiraIssueTreeFormatter formats an issue and its descendants as a nested list
+type AtlassianJiraIssueTreeFormatter struct {
+	root atlassian.AtlassianJiraIssueNode
+}
+
+// AtlassianJiraCreateIssueTreeFormatter creates a new issue tree formatter
+func AtlassianJiraCreateIssueTreeFormatter(root atlassian.AtlassianJiraIssueNode) *AtlassianJiraIssueTreeFormatter {
+	return &AtlassianJiraIssueTreeFormatter{
+		root: root,
+	}
+}
+
+// AtlassianJiraFormatIssueTreeAsMarkdown returns the issue tree as a nested markdown list
+func (f *AtlassianJiraIssueTreeFormatter) AtlassianJiraFormatIssueTreeAsMarkdown() string {
+	var output strings.Builder
+	writeIssueTreeNode(&output, f.root, 0)
+	return output.String()
+}
+
+// writeIssueTreeNode writes an issue and its children, indenting each level
+func writeIssueTreeNode(output *strings.Builder, node atlassian.AtlassianJiraIssueNode, depth int) {
+	issue := node.Issue
+	assignee := "Unassigned"
+	if issue.Fields.Assignee != nil {
+		assignee = issue.Fields.Assignee.DisplayName
+	}
+	output.WriteString(fmt.Sprintf("%s- **%s** %s · %s · %s · %s\n", strings.Repeat("  ", depth),
+		issue.Key, issue.Fields.Summary, issue.Fields.IssueType.Name, issue.Fields.Status.Name, assignee))
+	for _, child := range node.Children {
+		writeIssueTreeNode(output, child, depth+1)
+	}
+}
+
+// AtlassianJiraTransitionsFormatter formats the transitions available on an issue
+type AtlassianJiraTransitionsFormatter struct {
+	transitions []atlassian.AtlassianJiraTransition
+}
+
+// AtlassianJiraCreateTransitionsFormatter creates a new transitions formatter
+func AtlassianJiraCreateTransitionsFormatter(transitions []atlassian.AtlassianJiraTransition) *AtlassianJiraTransitionsFormatter {
+	return &AtlassianJiraTransitionsFormatter{
+		transitions: transitions,
+	}
+}
+
+// AtlassianJiraFormatTransitionsAsMarkdown returns the transitions as a markdown table
+func (f *AtlassianJiraTransitionsFormatter) AtlassianJiraFormatTransitionsAsMarkdown() string {
+	if len(f.transitions) == 0 {
+		return "No transitions available."
+	}
+
+	var output strings.Builder
+	output.WriteString("| Transition | To Status | Category | Required Fields | ID |\n")
+	output.WriteString("|------------|-----------|----------|-----------------|----|\n")
+	for _, transition := range f.transitions {
+		var required []string
+		for _, field := range transition.Fields {
+			if field.Required && !field.HasDefaultValue {
+				required = append(required, field.Name)
 			}
+		}
+		sort.Strings(required)
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+			transition.Name,
+			transition.To.Name,
+			transition.To.StatusCategory.Name,
+			strings.Join(required, ", "),
+			transition.ID,
+		))
+	}
+	return output.String()
+}
+
+// AtlassianJiraIssueResultsFormatter formats the per-issue outcome of a bulk operation
+type AtlassianJiraIssueResultsFormatter struct {
+	results []atlassian.AtlassianJiraIssueResult
+}
+
+// AtlassianJiraCreateIssueResultsFormatter creates a new issue results formatter
+func AtlassianJiraCreateIssueResultsFormatter(results []atlassian.AtlassianJiraIssueResult) *AtlassianJiraIssueResultsFormatter {
+	return &AtlassianJiraIssueResultsFormatter{
+		results: results,
+	}
+}
+
+// AtlassianJiraFormatIssueResultsAsMarkdown returns the results as a markdown table with a summary line
+func (f *AtlassianJiraIssueResultsFormatter) AtlassianJiraFormatIssueResultsAsMarkdown() string {
+	if len(f.results) == 0 {
+		return "No issues matched."
+	}
+
+	counts := make(map[string]int)
+	var output strings.Builder
+	output.WriteString("| Issue | Summary | Change | Result |\n")
+	output.WriteString("|-------|---------|--------|--------|\n")
+	for _, result := range f.results {
+		counts[result.Status]++
+		status := result.Status
+		if result.Message != "" {
+			status += ": " + result.Message
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n",
+			result.Key,
+			AtlassianConfluenceEscapeTableCell(util.TruncateText(result.Summary, 60)),
+			AtlassianConfluenceEscapeTableCell(result.Change),
+			AtlassianConfluenceEscapeTableCell(status),
+		))
+	}
+
+	var totals []string
+	for _, status := range []string{"done", "dry run", "skipped", "failed"} {
+		if counts[status] > 0 {
+			totals = append(totals, fmt.Sprintf("%d %s", counts[status], status))
+		}
+	}
+	output.WriteString(fmt.Sprintf("\n%d issues: %s\n", len(f.results), strings.Join(totals, ", ")))
+	return output.String()
+}
+
+// AtlassianJiraCommentsFormatter formats the comments of an issue
+type AtlassianJiraCommentsFormatter struct {
+	issueKey string
+	comments []atlassian.AtlassianJiraComment
+}
+
+// AtlassianJiraCreateCommentsFormatter creates a new comments formatter
+func AtlassianJiraCreateCommentsFormatter(issueKey string, comments []atlassian.AtlassianJiraComment) *AtlassianJiraCommentsFormatter {
+	return &AtlassianJiraCommentsFormatter{
+		issueKey: issueKey,
+		comments: comments,
+	}
+}
+
+// AtlassianJiraFormatCommentsAsMarkdown returns the comments as markdown
+func (f *AtlassianJiraCommentsFormatter) AtlassianJiraFormatCommentsAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# Comments on %s\n\n", f.issueKey))
+	if len(f.comments) == 0 {
+		output.WriteString("No comments found\n")
+		return output.String()
+	}
+
+	for _, comment := range f.comments {
+		writeJiraComment(&output, comment)
+	}
+	output.WriteString(fmt.Sprintf("%d comments\n", len(f.comments)))
+	return output.String()
+}
+
+// writeJiraComment writes a comment with its author, date, ID, and visibility, followed by its body
+func writeJiraComment(output *strings.Builder, comment atlassian.AtlassianJiraComment) {
+	if comment.Author != nil {
+		output.WriteString(fmt.Sprintf("**%s** ", comment.Author.DisplayName))
+	}
+	if comment.Created != "" {
+		t, err := util.ParseDate(comment.Created)
+		if err == nil {
+			output.WriteString(fmt.Sprintf("on %s", t.Format("Jan 02, 2006 15:04:05")))
+		} else {
+			output.WriteString(fmt.Sprintf("on %s", comment.Created))
+		}
+	}
+
+	var notes []string
+	if comment.ID != "" {
+		notes = append(notes, "ID "+comment.ID)
+	}
+	if comment.Updated != "" && comment.Updated != comment.Created {
+		notes = append(notes, "edited")
+	}
+	if comment.Visibility != nil {
+		notes = append(notes, fmt.Sprintf("visible to %s %s", comment.Visibility.Type, comment.Visibility.Value))
+	}
+	if comment.Internal() {
+		notes = append(notes, "internal")
+	}
+	if len(notes) > 0 {
+		output.WriteString(fmt.Sprintf(" (%s)", strings.Join(notes, ", ")))
+	}
+	output.WriteString("\n\n")
+
+	if comment.Body != nil {
+		doc := &atlassian.AtlassianDocument{
+			Type:    "doc",
+			Content: comment.Body.Content,
+			Version: comment.Body.Version,
+		}
+		if body, err := doc.AtlassianDocumentConvertToMarkdown(); err == nil {
+			output.WriteString(body)
 			output.WriteString("\n\n")
+		}
+	}
 
-			if comment.Body != nil {
-				doc := &atlassian.AtlassianDocument{
-					Type:    "doc",
-					Content: comment.Body.Content,
-					Version: comment.Body.Version,
-				}
-				if body, err := doc.AtlassianDocumentConvertToMarkdown(); err == nil {
-					output.WriteString(body)
-					output.WriteString("\n\n")
-				}
+	output.WriteString("---\n\n")
+}
+
+// AtlassianJiraFormatSearchRange describes which results of a search are shown, e.g.
+// "1-50 of 120". Totals are exact on the last page; elsewhere the approximate count is
+// used, and a negative approxTotal means the total is unknown.
+func AtlassianJiraFormatSearchRange(startAt, count int, isLast bool, approxTotal int) string {
+	first, last := startAt+1, startAt+count
+	switch {
+	case isLast:
+		return fmt.Sprintf("%d-%d of %d", first, last, last)
+	case approxTotal > last:
+		return fmt.Sprintf("%d-%d of about %d", first, last, approxTotal)
+	default:
+		return fmt.Sprintf("%d-%d of more than %d", first, last, last)
+	}
+}
+
+// jiraStandardFields are shown by the fixed parts of the issue layouts, so they are
+// left out when extra fields are listed
+var jiraStandardFields = map[string]bool{
+	"summary": true, "status": true, "priority": true, "project": true, "assignee": true,
+	"reporter": true, "description": true, "created": true, "updated": true,
+	"resolution": true, "issuetype": true, "comment": true, "parent": true,
+	"subtasks": true, "issuelinks": true, "attachment": true,
+}
+
+// AtlassianJiraFormatFieldValue renders the raw JSON value of a field as text, using the
+// field schema where the value alone is ambiguous. Users, options, cascading selects,
+// sprints, versions, and linked issues are shown by name; dates are formatted; rich text
+// is converted from Atlassian Document Format to markdown.
+func AtlassianJiraFormatFieldValue(raw json.RawMessage, schema *atlassian.AtlassianJiraFieldSchema) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+
+	valueType := ""
+	if schema != nil {
+		valueType = schema.Type
+		if valueType == "array" {
+			valueType = schema.Items
+		}
+	}
+	return formatJiraValue(value, valueType)
+}
+
+// AtlassianJiraFieldIsRichText reports whether a field value is an Atlassian document
+func AtlassianJiraFieldIsRichText(raw json.RawMessage) bool {
+	var doc struct {
+		Type string `json:"type"`
+	}
+	return json.Unmarshal(raw, &doc) == nil && doc.Type == "doc"
+}
+
+// formatJiraValue renders a decoded field value; valueType is the schema type of the value or its items
+func formatJiraValue(value interface{}, valueType string) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return formatJiraDate(v, valueType)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return yesNo(v)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			if text := formatJiraValue(item, valueType); text != "" {
+				items = append(items, text)
 			}
+		}
+		return strings.Join(items, ", ")
+	case map[string]interface{}:
+		return formatJiraObject(v, valueType)
+	}
+	return fmt.Sprint(value)
+}
 
-			output.WriteString("---\n\n")
+// formatJiraDate formats date and datetime values, leaving other strings unchanged
+func formatJiraDate(value, valueType string) string {
+	switch valueType {
+	case "date":
+		if t, err := time.Parse("2006-01-02", value); err == nil {
+			return t.Format("Jan 02, 2006")
+		}
+	case "datetime":
+		if t, err := util.ParseDate(value); err == nil {
+			return t.Format("Jan 02, 2006 15:04")
 		}
+	}
+	return value
+}
+
+// formatJiraObject renders the object values of users, options, sprints, issues, and other entities
+func formatJiraObject(v map[string]interface{}, valueType string) string {
+	text := func(key string) string {
+		if s, ok := v[key].(string); ok {
+			return s
+		}
+		return ""
+	}
+
+	switch {
+	case text("type") == "doc":
+		// Rich text custom fields hold an Atlassian document
+		data, _ := json.Marshal(v)
+		doc, err := atlassian.ParseDocument(string(data))
+		if err != nil {
+			return ""
+		}
+		markdown, err := doc.AtlassianDocumentConvertToMarkdown()
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(markdown)
+	case text("displayName") != "":
+		// Users and groups
+		return text("displayName")
+	case v["child"] != nil:
+		// Cascading selects: parent option > child option
+		if child, ok := v["child"].(map[string]interface{}); ok {
+			return fmt.Sprintf("%s > %s", text("value"), formatJiraObject(child, valueType))
+		}
+	case text("state") != "" && text("name") != "":
+		// Sprints
+		return fmt.Sprintf("%s (%s)", text("name"), strings.ToLower(text("state")))
+	case text("key") != "" && v["fields"] != nil:
+		// Linked issues, parents, and subtasks
+		if fields, ok := v["fields"].(map[string]interface{}); ok {
+			if summary, ok := fields["summary"].(string); ok && summary != "" {
+				return fmt.Sprintf("%s %s", text("key"), summary)
+			}
+		}
+		return text("key")
+	case v["originalEstimate"] != nil || v["timeSpent"] != nil || v["remainingEstimate"] != nil:
+		// Time tracking
+		var parts []string
+		for _, estimate := range []struct{ key, label string }{
+			{"originalEstimate", "original"}, {"remainingEstimate", "remaining"}, {"timeSpent", "spent"},
+		} {
+			if s := text(estimate.key); s != "" {
+				parts = append(parts, fmt.Sprintf("%s %s", s, estimate.label))
+			}
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	for _, key := range []string{"value", "name", "displayName", "key", "emailAddress"} {
+		if s := text(key); s != "" {
+			return s
+		}
+	}
+
+	// Anything else is summarized by its scalar values
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		if key == "self" || key == "id" || strings.HasSuffix(key, "Url") {
+			continue
+		}
+		switch v[key].(type) {
+		case string, float64, bool:
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s: %s", key, formatJiraValue(v[key], "")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// AtlassianJiraBoardsFormatter formats agile boards as a markdown table
+type AtlassianJiraBoardsFormatter struct {
+	boards []atlassian.AtlassianJiraBoard
+}
+
+// AtlassianJiraCreateBoardsFormatter creates a new boards formatter
+func AtlassianJiraCreateBoardsFormatter(boards []atlassian.AtlassianJiraBoard) *AtlassianJiraBoardsFormatter {
+	return &AtlassianJiraBoardsFormatter{
+		boards: boards,
+	}
+}
+
+// AtlassianJiraFormatBoardsAsMarkdown returns the boards as a markdown table
+func (f *AtlassianJiraBoardsFormatter) AtlassianJiraFormatBoardsAsMarkdown() string {
+	if len(f.boards) == 0 {
+		return "No boards found.\n"
+	}
+
+	var output strings.Builder
+	output.WriteString("| ID | Name | Type | Project |\n")
+	output.WriteString("|----|------|------|---------|\n")
+	for _, board := range f.boards {
+		project := ""
+		if board.Location != nil {
+			project = board.Location.ProjectKey
+		}
+		output.WriteString(fmt.Sprintf("| %d | %s | %s | %s |\n",
+			board.ID,
+			AtlassianConfluenceEscapeTableCell(board.Name),
+			strings.Title(board.Type),
+			project,
+		))
+	}
+	output.WriteString(fmt.Sprintf("\n%d boards\n", len(f.boards)))
+	return output.String()
+}
+
+// AtlassianJiraSprintsFormatter formats the sprints of a board as a markdown table
+type AtlassianJiraSprintsFormatter struct {
+	sprints []atlassian.AtlassianJiraSprint
+}
+
+// AtlassianJiraCreateSprintsFormatter creates a new sprints formatter
+func AtlassianJiraCreateSprintsFormatter(sprints []atlassian.AtlassianJiraSprint) *AtlassianJiraSprintsFormatter {
+	return &AtlassianJiraSprintsFormatter{
+		sprints: sprints,
+	}
+}
+
+// AtlassianJiraFormatSprintsAsMarkdown returns the sprints as a markdown table
+func (f *AtlassianJiraSprintsFormatter) AtlassianJiraFormatSprintsAsMarkdown() string {
+	if len(f.sprints) == 0 {
+		return "No sprints found.\n"
+	}
+
+	var output strings.Builder
+	output.WriteString("| ID | Name | State | Start | End | Goal |\n")
+	output.WriteString("|----|------|-------|-------|-----|------|\n")
+	for _, sprint := range f.sprints {
+		output.WriteString(fmt.Sprintf("| %d | %s | %s | %s | %s | %s |\n",
+			sprint.ID,
+			AtlassianConfluenceEscapeTableCell(sprint.Name),
+			strings.Title(sprint.State),
+			formatJiraDate(sprint.StartDate, "datetime"),
+			formatJiraDate(sprint.EndDate, "datetime"),
+			AtlassianConfluenceEscapeTableCell(util.TruncateText(sprint.Goal, 60)),
+		))
+	}
+	return output.String()
+}
+
+// AtlassianJiraSprintFormatter formats a sprint with its issues grouped by status
+type AtlassianJiraSprintFormatter struct {
+	sprint      atlassian.AtlassianJiraSprint
+	issues      []atlassian.AtlassianJiraIssue
+	pointsField string
+}
+
+// AtlassianJiraCreateSprintFormatter creates a new sprint formatter
+func AtlassianJiraCreateSprintFormatter(sprint atlassian.AtlassianJiraSprint, issues []atlassian.AtlassianJiraIssue) *AtlassianJiraSprintFormatter {
+	return &AtlassianJiraSprintFormatter{
+		sprint: sprint,
+		issues: issues,
+	}
+}
+
+// WithPointsField sets the ID of the field holding story points; without it no points are shown
+func (f *AtlassianJiraSprintFormatter) WithPointsField(fieldID string) *AtlassianJiraSprintFormatter {
+	f.pointsField = fieldID
+	return f
+}
+
+// AtlassianJiraFormatSprintAsMarkdown returns the sprint details and its issues grouped by
+// status, to-do statuses first, with issue counts and story point totals
+func (f *AtlassianJiraSprintFormatter) AtlassianJiraFormatSprintAsMarkdown() string {
+	sprint := f.sprint
+	var output strings.Builder
+
+	output.WriteString(fmt.Sprintf("# %s\n\n", sprint.Name))
+	output.WriteString(fmt.Sprintf("- **ID**: %d\n", sprint.ID))
+	output.WriteString(fmt.Sprintf("- **State**: %s\n", strings.Title(sprint.State)))
+	if sprint.StartDate != "" || sprint.EndDate != "" {
+		output.WriteString(fmt.Sprintf("- **Dates**: %s – %s\n", formatJiraDate(sprint.StartDate, "datetime"), formatJiraDate(sprint.EndDate, "datetime")))
+	}
+	if sprint.CompleteDate != "" {
+		output.WriteString(fmt.Sprintf("- **Completed**: %s\n", formatJiraDate(sprint.CompleteDate, "datetime")))
+	}
+	if sprint.Goal != "" {
+		output.WriteString(fmt.Sprintf("- **Goal**: %s\n", sprint.Goal))
+	}
+	output.WriteString("\n")
+
+	if len(f.issues) == 0 {
+		output.WriteString("No issues in this sprint.\n")
+		return output.String()
+	}
+
+	// Group by status, ordered by status category and then by first appearance
+	var statuses []string
+	grouped := make(map[string][]atlassian.AtlassianJiraIssue)
+	category := make(map[string]string)
+	for _, issue := range f.issues {
+		status := issue.Fields.Status.Name
+		if _, ok := grouped[status]; !ok {
+			statuses = append(statuses, status)
+			category[status] = issue.Fields.Status.StatusCategory.Key
+		}
+		grouped[status] = append(grouped[status], issue)
+	}
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return jiraStatusCategoryOrder(category[statuses[i]]) < jiraStatusCategoryOrder(category[statuses[j]])
+	})
+
+	var totalPoints, donePoints float64
+	for _, status := range statuses {
+		issues := grouped[status]
+		points := jiraTotalPoints(issues, f.pointsField)
+		totalPoints += points
+		if category[status] == "done" {
+			donePoints += points
+		}
+
+		output.WriteString(fmt.Sprintf("## %s (%s)\n\n", status, jiraAgileCountSummary(len(issues), points, f.pointsField)))
+		output.WriteString(jiraAgileIssueTable(issues, f.pointsField))
+		output.WriteString("\n")
+	}
+
+	output.WriteString(fmt.Sprintf("**Total**: %s", jiraAgileCountSummary(len(f.issues), totalPoints, f.pointsField)))
+	if f.pointsField != "" {
+		output.WriteString(fmt.Sprintf(", %s done", formatJiraPoints(donePoints)))
+	}
+	output.WriteString("\n")
+	return output.String()
+}
+
+// AtlassianJiraBacklogFormatter formats the backlog of a board in rank order
+type AtlassianJiraBacklogFormatter struct {
+	issues      []atlassian.AtlassianJiraIssue
+	pointsField string
+}
+
+// AtlassianJiraCreateBacklogFormatter creates a new backlog formatter
+func AtlassianJiraCreateBacklogFormatter(issues []atlassian.AtlassianJiraIssue) *AtlassianJiraBacklogFormatter {
+	return &AtlassianJiraBacklogFormatter{
+		issues: issues,
+	}
+}
+
+// WithPointsField sets the ID of the field holding story points; without it no points are shown
+func (f *AtlassianJiraBacklogFormatter) WithPointsField(fieldID string) *AtlassianJiraBacklogFormatter {
+	f.pointsField = fieldID
+	return f
+}
+
+// AtlassianJiraFormatBacklogAsMarkdown returns the backlog as one table with its totals
+func (f *AtlassianJiraBacklogFormatter) AtlassianJiraFormatBacklogAsMarkdown() string {
+	if len(f.issues) == 0 {
+		return "The backlog is empty.\n"
+	}
+
+	var output strings.Builder
+	output.WriteString(jiraAgileIssueTable(f.issues, f.pointsField))
+	output.WriteString(fmt.Sprintf("\n**Total**: %s\n", jiraAgileCountSummary(len(f.issues), jiraTotalPoints(f.issues, f.pointsField), f.pointsField)))
+	return output.String()
+}
+
+// jiraAgileIssueTable writes issues as a table, with a points column when the points field is known
+func jiraAgileIssueTable(issues []atlassian.AtlassianJiraIssue, pointsField string) string {
+	var output strings.Builder
+	if pointsField != "" {
+		output.WriteString("| Key | Summary | Type | Status | Assignee | Points |\n")
+		output.WriteString("|-----|---------|------|--------|----------|--------|\n")
 	} else {
-		output.WriteString("## Comments\n\nNo comments found\n")
+		output.WriteString("| Key | Summary | Type | Status | Assignee |\n")
+		output.WriteString("|-----|---------|------|--------|----------|\n")
 	}
 
+	for _, issue := range issues {
+		assignee := "Unassigned"
+		if issue.Fields.Assignee != nil {
+			assignee = issue.Fields.Assignee.DisplayName
+		}
+		row := fmt.Sprintf("| %s | %s | %s | %s | %s |",
+			issue.Key,
+			AtlassianConfluenceEscapeTableCell(util.TruncateText(issue.Fields.Summary, 60)),
+			issue.Fields.IssueType.Name,
+			issue.Fields.Status.Name,
+			AtlassianConfluenceEscapeTableCell(assignee),
+		)
+		if pointsField != "" {
+			points := ""
+			if value, ok := jiraIssuePoints(issue, pointsField); ok {
+				points = formatJiraPoints(value)
+			}
+			row += fmt.Sprintf(" %s |", points)
+		}
+		output.WriteString(row + "\n")
+	}
 	return output.String()
 }
+
+// jiraTotalPoints adds up the story points of the issues
+func jiraTotalPoints(issues []atlassian.AtlassianJiraIssue, pointsField string) float64 {
+	var total float64
+	for _, issue := range issues {
+		if value, ok := jiraIssuePoints(issue, pointsField); ok {
+			total += value
+		}
+	}
+	return total
+}
+
+// jiraAgileCountSummary describes a number of issues and, when the points field is known, their points
+func jiraAgileCountSummary(count int, points float64, pointsField string) string {
+	summary := fmt.Sprintf("%d issues", count)
+	if count == 1 {
+		summary = "1 issue"
+	}
+	if pointsField != "" {
+		summary += fmt.Sprintf(", %s points", formatJiraPoints(points))
+	}
+	return summary
+}
+
+// jiraIssuePoints returns the numeric value of the story points field of an issue
+func jiraIssuePoints(issue atlassian.AtlassianJiraIssue, fieldID string) (float64, bool) {
+	if fieldID == "" {
+		return 0, false
+	}
+	raw, ok := issue.FieldValue(fieldID)
+	if !ok {
+		return 0, false
+	}
+	var value *float64
+	if err := json.Unmarshal(raw, &value); err != nil || value == nil {
+		return 0, false
+	}
+	return *value, true
+}
+
+// formatJiraPoints formats story points without trailing zeros, e.g. "3" or "0.5"
+func formatJiraPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
+
+// jiraStatusCategoryOrder sorts status categories from to do to done
+func jiraStatusCategoryOrder(key string) int {
+	switch key {
+	case "new":
+		return 0
+	case "indeterminate":
+		return 1
+	case "done":
+		return 2
+	}
+	return 1
+}
+
+// AtlassianJiraHistoryFormatter formats the change history of an issue
+type AtlassianJiraHistoryFormatter struct {
+	issueKey   string
+	changelogs []atlassian.AtlassianJiraChangelog
+	periods    []atlassian.AtlassianJiraStatusPeriod
+}
+
+// AtlassianJiraCreateHistoryFormatter creates a new history formatter
+func AtlassianJiraCreateHistoryFormatter(issueKey string, changelogs []atlassian.AtlassianJiraChangelog) *AtlassianJiraHistoryFormatter {
+	return &AtlassianJiraHistoryFormatter{
+		issueKey:   issueKey,
+		changelogs: changelogs,
+	}
+}
+
+// WithStatusPeriods adds a status timeline with the time spent in each status
+func (f *AtlassianJiraHistoryFormatter) WithStatusPeriods(periods []atlassian.AtlassianJiraStatusPeriod) *AtlassianJiraHistoryFormatter {
+	f.periods = periods
+	return f
+}
+
+// AtlassianJiraFormatHistoryAsMarkdown returns the status timeline followed by every change,
+// oldest first. Rich text changes such as descriptions are shown as a diff of their markdown.
+func (f *AtlassianJiraHistoryFormatter) AtlassianJiraFormatHistoryAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# History of %s\n\n", f.issueKey))
+
+	if len(f.periods) > 0 {
+		output.WriteString("## Status Timeline\n\n")
+		output.WriteString("| Status | Entered | Left | Duration | Moved By |\n")
+		output.WriteString("|--------|---------|------|----------|----------|\n")
+
+		var statuses []string
+		totals := make(map[string]int64)
+		for _, period := range f.periods {
+			left := "Now"
+			if period.Left != "" {
+				left = formatJiraDate(period.Left, "datetime")
+			}
+			output.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+				period.Status,
+				formatJiraDate(period.Entered, "datetime"),
+				left,
+				util.FormatDuration(time.Duration(period.Seconds)*time.Second),
+				AtlassianConfluenceEscapeTableCell(period.By),
+			))
+			if _, ok := totals[period.Status]; !ok {
+				statuses = append(statuses, period.Status)
+			}
+			totals[period.Status] += period.Seconds
+		}
+
+		// Statuses can be entered more than once, so also show the total per status
+		parts := make([]string, 0, len(statuses))
+		for _, status := range statuses {
+			parts = append(parts, fmt.Sprintf("%s %s", status, util.FormatDuration(time.Duration(totals[status])*time.Second)))
+		}
+		output.WriteString(fmt.Sprintf("\n**Time in status**: %s\n\n", strings.Join(parts, " · ")))
+	}
+
+	output.WriteString("## Changes\n\n")
+	if len(f.changelogs) == 0 {
+		output.WriteString("No changes found.\n")
+		return output.String()
+	}
+
+	for _, changelog := range f.changelogs {
+		author := "Unknown"
+		if changelog.Author != nil {
+			author = changelog.Author.DisplayName
+		}
+		output.WriteString(fmt.Sprintf("### %s · %s\n\n", formatJiraDate(changelog.Created, "datetime"), author))
+
+		var diffs []string
+		for _, item := range changelog.Items {
+			from := jiraChangelogText(item.FromString)
+			to := jiraChangelogText(item.ToString)
+			if strings.Contains(from, "\n") || strings.Contains(to, "\n") {
+				output.WriteString(fmt.Sprintf("- **%s**: changed (see below)\n", item.Field))
+				changes := diff.Unified("before", "after", from, to, 2)
+				diffs = append(diffs, fmt.Sprintf("**%s**\n\n%s", item.Field, util.FencedCodeBlock("diff", changes)))
+				continue
+			}
+			output.WriteString(fmt.Sprintf("- **%s**: %s → %s\n", item.Field, jiraChangelogValue(from), jiraChangelogValue(to)))
+		}
+		output.WriteString("\n")
+		for _, d := range diffs {
+			output.WriteString(d + "\n")
+		}
+	}
+
+	return output.String()
+}
+
+// jiraChangelogText returns the text of a changed value, converting rich text held as
+// Atlassian Document Format to markdown
+func jiraChangelogText(value string) string {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "{") {
+		if doc, err := atlassian.ParseDocument(value); err == nil && doc.Type == "doc" {
+			if md, err := doc.AtlassianDocumentConvertToMarkdown(); err == nil {
+				return strings.TrimSpace(md)
+			}
+		}
+	}
+	return value
+}
+
+// jiraChangelogValue shows a single-line changed value, marking empty values
+func jiraChangelogValue(value string) string {
+	if value == "" {
+		return "_None_"
+	}
+	return value
+}
+
+// AtlassianJiraWorklogsFormatter formats the worklogs of an issue as a markdown table
+type AtlassianJiraWorklogsFormatter struct {
+	issueKey string
+	worklogs []atlassian.AtlassianJiraWorklog
+}
+
+// AtlassianJiraCreateWorklogsFormatter creates a new worklogs formatter
+func AtlassianJiraCreateWorklogsFormatter(issueKey string, worklogs []atlassian.AtlassianJiraWorklog) *AtlassianJiraWorklogsFormatter {
+	return &AtlassianJiraWorklogsFormatter{
+		issueKey: issueKey,
+		worklogs: worklogs,
+	}
+}
+
+// AtlassianJiraFormatWorklogsAsMarkdown returns the worklogs as a table with the total time spent
+func (f *AtlassianJiraWorklogsFormatter) AtlassianJiraFormatWorklogsAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# Worklogs of %s\n\n", f.issueKey))
+	if len(f.worklogs) == 0 {
+		output.WriteString("No time logged.\n")
+		return output.String()
+	}
+
+	output.WriteString("| Started | Author | Time Spent | Comment | ID |\n")
+	output.WriteString("|---------|--------|------------|---------|----|\n")
+	var total int64
+	for _, worklog := range f.worklogs {
+		author := ""
+		if worklog.Author != nil {
+			author = worklog.Author.DisplayName
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+			formatJiraDate(worklog.Started, "datetime"),
+			AtlassianConfluenceEscapeTableCell(author),
+			worklog.TimeSpent,
+			AtlassianConfluenceEscapeTableCell(util.TruncateText(jiraWorklogComment(worklog), 60)),
+			worklog.ID,
+		))
+		total += worklog.TimeSpentSeconds
+	}
+	output.WriteString(fmt.Sprintf("\n**Total**: %s in %d worklogs\n", formatJiraHours(total), len(f.worklogs)))
+	return output.String()
+}
+
+// AtlassianJiraTimesheetFormatter formats the time a user logged across issues
+type AtlassianJiraTimesheetFormatter struct {
+	user    string
+	from    time.Time
+	to      time.Time
+	entries []atlassian.AtlassianJiraTimesheetEntry
+}
+
+// AtlassianJiraCreateTimesheetFormatter creates a new timesheet formatter for the days from
+// from up to and including to
+func AtlassianJiraCreateTimesheetFormatter(user string, from, to time.Time, entries []atlassian.AtlassianJiraTimesheetEntry) *AtlassianJiraTimesheetFormatter {
+	return &AtlassianJiraTimesheetFormatter{
+		user:    user,
+		from:    from,
+		to:      to,
+		entries: entries,
+	}
+}
+
+// AtlassianJiraFormatTimesheetAsMarkdown returns the time logged per day and per issue, with totals
+func (f *AtlassianJiraTimesheetFormatter) AtlassianJiraFormatTimesheetAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# Timesheet of %s\n\n", f.user))
+	output.WriteString(fmt.Sprintf("%s – %s\n\n", f.from.Format("Mon Jan 02, 2006"), f.to.Format("Mon Jan 02, 2006")))
+	if len(f.entries) == 0 {
+		output.WriteString("No time logged.\n")
+		return output.String()
+	}
+
+	// Entries are sorted by start time, so days come out in order
+	var days, issues []string
+	dayTotals := make(map[string]int64)
+	dayIssues := make(map[string][]string)
+	seen := make(map[string]bool)
+	issueTotals := make(map[string]int64)
+	summaries := make(map[string]string)
+	var total int64
+	for _, entry := range f.entries {
+		day := jiraWorklogDay(entry.Worklog)
+		seconds := entry.Worklog.TimeSpentSeconds
+		if _, ok := dayTotals[day]; !ok {
+			days = append(days, day)
+		}
+		if _, ok := issueTotals[entry.IssueKey]; !ok {
+			issues = append(issues, entry.IssueKey)
+			summaries[entry.IssueKey] = entry.Summary
+		}
+		if !seen[day+" "+entry.IssueKey] {
+			seen[day+" "+entry.IssueKey] = true
+			dayIssues[day] = append(dayIssues[day], entry.IssueKey)
+		}
+		dayTotals[day] += seconds
+		issueTotals[entry.IssueKey] += seconds
+		total += seconds
+	}
+
+	output.WriteString("## By Day\n\n")
+	output.WriteString("| Day | Time | Issues |\n")
+	output.WriteString("|-----|------|--------|\n")
+	for _, day := range days {
+		label := day
+		if t, err := time.Parse("2006-01-02", day); err == nil {
+			label = t.Format("Mon Jan 02")
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s |\n", label, formatJiraHours(dayTotals[day]), strings.Join(dayIssues[day], ", ")))
+	}
+	output.WriteString(fmt.Sprintf("| **Total** | **%s** | |\n\n", formatJiraHours(total)))
+
+	output.WriteString("## By Issue\n\n")
+	output.WriteString("| Issue | Summary | Time |\n")
+	output.WriteString("|-------|---------|------|\n")
+	for _, key := range issues {
+		output.WriteString(fmt.Sprintf("| %s | %s | %s |\n", key, AtlassianConfluenceEscapeTableCell(util.TruncateText(summaries[key], 60)), formatJiraHours(issueTotals[key])))
+	}
+	output.WriteString(fmt.Sprintf("| **Total** | | **%s** |\n", formatJiraHours(total)))
+	return output.String()
+}
+
+// AtlassianJiraFormatTimesheetAsCSV returns one CSV row per worklog with the hours as a decimal number
+func (f *AtlassianJiraTimesheetFormatter) AtlassianJiraFormatTimesheetAsCSV() (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"Date", "Issue", "Summary", "Hours", "Comment"})
+	for _, entry := range f.entries {
+		w.Write([]string{
+			jiraWorklogDay(entry.Worklog),
+			entry.IssueKey,
+			entry.Summary,
+			strconv.FormatFloat(float64(entry.Worklog.TimeSpentSeconds)/3600, 'f', 2, 64),
+			jiraWorklogComment(entry.Worklog),
+		})
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// jiraWorklogDay returns the local date a worklog was started on as YYYY-MM-DD
+func jiraWorklogDay(worklog atlassian.AtlassianJiraWorklog) string {
+	started, err := util.ParseDate(worklog.Started)
+	if err != nil {
+		return worklog.Started
+	}
+	return started.Local().Format("2006-01-02")
+}
+
+// jiraWorklogComment returns the comment of a worklog as plain text
+func jiraWorklogComment(worklog atlassian.AtlassianJiraWorklog) string {
+	if worklog.Comment == nil {
+		return ""
+	}
+	return strings.TrimSpace(worklog.Comment.AtlassianDocumentPlainText())
+}
+
+// formatJiraHours formats logged seconds as hours and minutes, e.g. "37h 30m"
+func formatJiraHours(seconds int64) string {
+	hours := seconds / 3600
+	minutes := seconds % 3600 / 60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	}
+	return fmt.Sprintf("%dh %dm", hours, minutes)
+}
+
+// AtlassianJiraAttachmentsFormatter formats the attachments of an issue as a markdown table
+type AtlassianJiraAttachmentsFormatter struct {
+	issueKey    string
+	attachments []atlassian.AtlassianJiraAttachment
+}
+
+// AtlassianJiraCreateAttachmentsFormatter creates a new attachments formatter
+func AtlassianJiraCreateAttachmentsFormatter(issueKey string, attachments []atlassian.AtlassianJiraAttachment) *AtlassianJiraAttachmentsFormatter {
+	return &AtlassianJiraAttachmentsFormatter{
+		issueKey:    issueKey,
+		attachments: attachments,
+	}
+}
+
+// AtlassianJiraFormatAttachmentsAsMarkdown returns the attachments as a table with their total size
+func (f *AtlassianJiraAttachmentsFormatter) AtlassianJiraFormatAttachmentsAsMarkdown() string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("# Attachments on %s\n\n", f.issueKey))
+	if len(f.attachments) == 0 {
+		output.WriteString("No attachments found.\n")
+		return output.String()
+	}
+
+	writeJiraAttachmentsTable(&output, f.attachments, nil)
+
+	var total int64
+	for _, attachment := range f.attachments {
+		total += attachment.Size
+	}
+	output.WriteString(fmt.Sprintf("\nShowing %d attachments (%s)\n", len(f.attachments), util.FormatBytes(total)))
+	return output.String()
+}
+
+// writeJiraAttachmentsTable writes attachments as a table linking to their local copy
+// in links, or to their content in Jira
+func writeJiraAttachmentsTable(output *strings.Builder, attachments []atlassian.AtlassianJiraAttachment, links map[string]string) {
+	output.WriteString("| Name | Type | Size | Author | Created | ID |\n")
+	output.WriteString("|------|------|------|--------|---------|----|\n")
+	for _, attachment := range attachments {
+		name := AtlassianConfluenceEscapeTableCell(attachment.Filename)
+		if link := jiraAttachmentLink(attachment, links); link != "" {
+			name = fmt.Sprintf("[%s](%s)", name, link)
+		}
+		author := ""
+		if attachment.Author != nil {
+			author = attachment.Author.DisplayName
+		}
+		output.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s |\n",
+			name,
+			attachment.MimeType,
+			util.FormatBytes(attachment.Size),
+			AtlassianConfluenceEscapeTableCell(author),
+			formatJiraDate(attachment.Created, "datetime"),
+			attachment.ID,
+		))
+	}
+}
+
+// jiraAttachmentURL resolves the media nodes of an issue description to its attachments.
+// Jira media nodes reference the media store, but carry the file name as their alt text.
+func jiraAttachmentURL(attachments []atlassian.AtlassianJiraAttachment, links map[string]string) func(attrs atlassian.AtlassianAttributes) string {
+	return func(attrs atlassian.AtlassianAttributes) string {
+		alt, _ := attrs.Other["alt"].(string)
+		if alt == "" {
+			return ""
+		}
+		for _, attachment := range attachments {
+			if attachment.Filename == alt {
+				return jiraAttachmentLink(attachment, links)
+			}
+		}
+		return ""
+	}
+}
+
+// jiraAttachmentLink returns the local path of an attachment in links, or its content URL
+func jiraAttachmentLink(attachment atlassian.AtlassianJiraAttachment, links map[string]string) string {
+	if link, ok := links[attachment.ID]; ok {
+		return link
+	}
+	return attachment.Content
+}
@@ -0,0 +1,55 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// frontmatterDelimiter separates YAML frontmatter from the markdown body
+const frontmatterDelimiter = "---"
+
+// AddFrontmatter prepends the YAML encoding of meta to the markdown body
+func AddFrontmatter(meta interface{}, body string) (string, error) {
+	data, err := yaml.Marshal(meta)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode frontmatter: %w", err)
+	}
+
+	var output strings.Builder
+	output.WriteString(frontmatterDelimiter + "\n")
+	output.Write(data)
+	output.WriteString(frontmatterDelimiter + "\n\n")
+	output.WriteString(strings.TrimLeft(body, "\n"))
+	return output.String(), nil
+}
+
+// SplitFrontmatter separates YAML frontmatter from the markdown body and decodes it into meta.
+// Content without frontmatter is returned unchanged and meta is left untouched.
+func SplitFrontmatter(content string, meta interface{}) (string, error) {
+	normalized := strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(normalized, frontmatterDelimiter+"\n") {
+		return content, nil
+	}
+
+	rest := normalized[len(frontmatterDelimiter)+1:]
+	end := strings.Index(rest, "\n"+frontmatterDelimiter+"\n")
+	var header, body string
+	switch {
+	case end >= 0:
+		header, body = rest[:end], rest[end+len(frontmatterDelimiter)+2:]
+	case strings.HasSuffix(rest, "\n"+frontmatterDelimiter):
+		header = strings.TrimSuffix(rest, "\n"+frontmatterDelimiter)
+	case strings.HasPrefix(rest, frontmatterDelimiter+"\n"):
+		// Empty frontmatter block
+		body = rest[len(frontmatterDelimiter)+1:]
+	default:
+		return "", fmt.Errorf("frontmatter is not terminated by %q", frontmatterDelimiter)
+	}
+
+	if err := yaml.Unmarshal([]byte(header), meta); err != nil {
+		return "", fmt.Errorf("failed to parse frontmatter: %w", err)
+	}
+	return strings.TrimLeft(body, "\n"), nil
+}
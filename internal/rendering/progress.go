@@ -0,0 +1,65 @@
+package rendering
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"markcli/internal/util"
+)
+
+// Progress reports the progress of a transfer on stderr. It implements io.Writer so it can
+// be combined with io.TeeReader or io.MultiWriter to count bytes as they stream through.
+// Nothing is drawn when stderr is not a terminal, so scripted output stays clean.
+type Progress struct {
+	label    string
+	total    int64
+	current  int64
+	out      io.Writer
+	enabled  bool
+	lastDraw time.Time
+}
+
+// NewProgress creates a progress indicator for a transfer of total bytes.
+// A total of zero or less means the size is unknown.
+func NewProgress(label string, total int64) *Progress {
+	enabled := false
+	if info, err := os.Stderr.Stat(); err == nil {
+		enabled = info.Mode()&os.ModeCharDevice != 0
+	}
+	return &Progress{
+		label:   label,
+		total:   total,
+		out:     os.Stderr,
+		enabled: enabled,
+	}
+}
+
+// Write counts the transferred bytes and redraws the indicator at most ten times a second
+func (p *Progress) Write(b []byte) (int, error) {
+	p.current += int64(len(b))
+	if p.enabled && time.Since(p.lastDraw) >= 100*time.Millisecond {
+		p.draw()
+		p.lastDraw = time.Now()
+	}
+	return len(b), nil
+}
+
+// Done draws the final state and ends the progress line
+func (p *Progress) Done() {
+	if !p.enabled {
+		return
+	}
+	p.draw()
+	fmt.Fprintln(p.out)
+}
+
+func (p *Progress) draw() {
+	if p.total > 0 {
+		fmt.Fprintf(p.out, "\r%s: %s / %s (%d%%)", p.label,
+			util.FormatBytes(p.current), util.FormatBytes(p.total), p.current*100/p.total)
+		return
+	}
+	fmt.Fprintf(p.out, "\r%s: %s", p.label, util.FormatBytes(p.current))
+}
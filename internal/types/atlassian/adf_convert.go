@@ -87,6 +87,8 @@ func (content *AtlassianContent) AtlassianDocumentConvertToMarkdown() (string, e
 		return content.convertExpand()
 	case "inlineExtension":
 		return content.convertInlineExtension()
+	case "blockquote":
+		return content.convertBlockquote()
 	default:
 		return "", fmt.Errorf("unsupported content type: %s", content.Type)
 	}
@@ -125,8 +127,14 @@ func (content *AtlassianContent) convertText() (string, error) {
 				text = "_" + text + "_"
 			case "code":
 				text = "`" + text + "`"
+			case "strike":
+				text = "~~" + text + "~~"
 			case "link":
-				text = "[" + text + "](" + mark.Attrs.URL + ")"
+				href := mark.Attrs.Href
+				if href == "" {
+					href = mark.Attrs.URL
+				}
+				text = "[" + text + "](" + href + ")"
 			case "textColor":
 				// Skip color formatting in markdown
 			}
@@ -509,6 +517,24 @@ func (content *AtlassianContent) convertInlineExtension() (string, error) {
 	return "", nil
 }
 
+func (content *AtlassianContent) convertBlockquote() (string, error) {
+	var quote strings.Builder
+	for _, child := range content.Content {
+		text, err := child.AtlassianDocumentConvertToMarkdown()
+		if err != nil {
+			return "", err
+		}
+		quote.WriteString(text)
+	}
+
+	// Prefix every line with a quote marker
+	lines := strings.Split(strings.TrimSpace(quote.String()), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return strings.Join(lines, "\n") + "\n\n", nil
+}
+
 // ParseDocument parses a JSON string into an AtlassianDocument
 func ParseDocument(jsonStr string) (*AtlassianDocument, error) {
 	var doc AtlassianDocument
@@ -526,3 +552,55 @@ func AtlassianDocumentConvertJSONToMarkdown(jsonStr string) (string, error) {
 	}
 	return doc.AtlassianDocumentConvertToMarkdown()
 }
+
+// AtlassianDocumentPlainText returns the text of the document without any formatting.
+// Block nodes are separated by newlines.
+func (doc *AtlassianDocument) AtlassianDocumentPlainText() string {
+	var text strings.Builder
+	for _, content := range doc.Content {
+		content.writePlainText(&text)
+	}
+	return text.String()
+}
+
+// writePlainText appends the text of a node and its children
+func (content *AtlassianContent) writePlainText(text *strings.Builder) {
+	if content.Type == "text" {
+		text.WriteString(content.Text)
+		return
+	}
+	for _, child := range content.Content {
+		child.writePlainText(text)
+	}
+	switch content.Type {
+	case "paragraph", "heading", "codeBlock", "listItem", "taskItem", "tableCell", "tableHeader", "hardBreak":
+		text.WriteString("\n")
+	}
+}
+
+// AtlassianDocumentResolveMedia sets the URL of media nodes that have none, such as files
+// in the Jira or Confluence media store, to the URL returned by resolve for the node's
+// attributes. resolve returns "" to leave a node without a URL.
+func (doc *AtlassianDocument) AtlassianDocumentResolveMedia(resolve func(attrs AtlassianAttributes) string) {
+	resolveMedia(doc.Content, resolve)
+}
+
+// resolveMedia resolves the media nodes in a list of nodes and their children.
+// A mediaSingle node is rendered from its own URL, so it takes the URL of its media.
+func resolveMedia(nodes []AtlassianContent, resolve func(attrs AtlassianAttributes) string) {
+	for i := range nodes {
+		resolveMedia(nodes[i].Content, resolve)
+		switch {
+		case nodes[i].Attrs.URL != "":
+		case nodes[i].Type == "media":
+			nodes[i].Attrs.URL = resolve(nodes[i].Attrs)
+		case nodes[i].Type == "mediaSingle":
+			for _, child := range nodes[i].Content {
+				if child.Type == "media" && child.Attrs.URL != "" {
+					nodes[i].Attrs.URL = child.Attrs.URL
+					break
+				}
+			}
+		}
+	}
+}
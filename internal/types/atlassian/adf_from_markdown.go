@@ -0,0 +1,397 @@
+package atlassian
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// markdownParser parses GitHub flavored markdown, including tables, strikethrough and task lists
+var markdownParser = goldmark.New(goldmark.WithExtensions(extension.GFM)).Parser()
+
+// AtlassianDocumentConvertMarkdownToDocument converts markdown to an Atlassian document
+func AtlassianDocumentConvertMarkdownToDocument(markdown string) *AtlassianDocument {
+	source := []byte(markdown)
+	root := markdownParser.Parse(text.NewReader(source))
+
+	converter := &markdownConverter{source: source}
+	return &AtlassianDocument{
+		Type:    "doc",
+		Version: 1,
+		Content: converter.convertBlocks(root),
+	}
+}
+
+// AtlassianDocumentConvertMarkdownToJSON converts markdown to an ADF JSON string
+func AtlassianDocumentConvertMarkdownToJSON(markdown string) (string, error) {
+	doc := AtlassianDocumentConvertMarkdownToDocument(markdown)
+	jsonStr, err := doc.AtlassianDocumentToJSON()
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize document: %w", err)
+	}
+	return jsonStr, nil
+}
+
+// markdownConverter walks a goldmark AST and builds ADF nodes
+type markdownConverter struct {
+	source []byte
+}
+
+// convertBlocks converts the block children of a node
+func (c *markdownConverter) convertBlocks(parent gast.Node) []AtlassianContent {
+	var blocks []AtlassianContent
+	for node := parent.FirstChild(); node != nil; node = node.NextSibling() {
+		blocks = append(blocks, c.convertBlock(node)...)
+	}
+	return blocks
+}
+
+// convertBlock converts a single block node; some markdown blocks map to several ADF nodes
+func (c *markdownConverter) convertBlock(node gast.Node) []AtlassianContent {
+	switch n := node.(type) {
+	case *gast.Paragraph, *gast.TextBlock:
+		return c.convertParagraph(n)
+	case *gast.Heading:
+		heading := AtlassianContent{
+			Type:    "heading",
+			Content: c.convertInlines(n, nil),
+		}
+		heading.Attrs.Level = n.Level
+		return []AtlassianContent{heading}
+	case *gast.ThematicBreak:
+		return []AtlassianContent{{Type: "rule"}}
+	case *gast.FencedCodeBlock:
+		block := c.codeBlock(n)
+		block.Attrs.Language = string(n.Language(c.source))
+		return []AtlassianContent{block}
+	case *gast.CodeBlock:
+		return []AtlassianContent{c.codeBlock(n)}
+	case *gast.Blockquote:
+		return []AtlassianContent{{
+			Type:    "blockquote",
+			Content: c.convertBlocks(n),
+		}}
+	case *gast.List:
+		return []AtlassianContent{c.convertList(n)}
+	case *east.Table:
+		return []AtlassianContent{c.convertTable(n)}
+	case *gast.HTMLBlock:
+		raw := strings.TrimSpace(c.lines(n))
+		if raw == "" {
+			return nil
+		}
+		return []AtlassianContent{paragraph([]AtlassianContent{{Type: "text", Text: raw}})}
+	default:
+		// Unknown containers still carry content worth keeping
+		return c.convertBlocks(n)
+	}
+}
+
+// convertParagraph converts a paragraph. Images on their own become media blocks,
+// since ADF does not allow media inside a paragraph.
+func (c *markdownConverter) convertParagraph(node gast.Node) []AtlassianContent {
+	if image, ok := node.FirstChild().(*gast.Image); ok && image.NextSibling() == nil {
+		return []AtlassianContent{mediaSingle(string(image.Destination))}
+	}
+
+	inlines := c.convertInlines(node, nil)
+	if len(inlines) == 0 {
+		return nil
+	}
+	return []AtlassianContent{paragraph(inlines)}
+}
+
+// codeBlock converts an indented or fenced code block
+func (c *markdownConverter) codeBlock(node gast.Node) AtlassianContent {
+	code := strings.TrimSuffix(c.lines(node), "\n")
+	block := AtlassianContent{Type: "codeBlock"}
+	if code != "" {
+		block.Content = []AtlassianContent{{Type: "text", Text: code}}
+	}
+	return block
+}
+
+// convertList converts bullet, ordered and task lists
+func (c *markdownConverter) convertList(list *gast.List) AtlassianContent {
+	if c.isTaskList(list) {
+		return c.convertTaskList(list)
+	}
+
+	result := AtlassianContent{Type: "bulletList"}
+	if list.IsOrdered() {
+		result.Type = "orderedList"
+		if list.Start > 1 {
+			result.Attrs.Other = map[string]interface{}{"order": list.Start}
+		}
+	}
+
+	for item := list.FirstChild(); item != nil; item = item.NextSibling() {
+		content := c.convertBlocks(item)
+		if len(content) == 0 {
+			content = []AtlassianContent{paragraph(nil)}
+		}
+		result.Content = append(result.Content, AtlassianContent{
+			Type:    "listItem",
+			Content: content,
+		})
+	}
+	return result
+}
+
+// isTaskList reports whether every item of the list starts with a checkbox
+func (c *markdownConverter) isTaskList(list *gast.List) bool {
+	for item := list.FirstChild(); item != nil; item = item.NextSibling() {
+		block := item.FirstChild()
+		if block == nil {
+			return false
+		}
+		if _, ok := block.FirstChild().(*east.TaskCheckBox); !ok {
+			return false
+		}
+	}
+	return list.FirstChild() != nil
+}
+
+// convertTaskList converts a list of checkboxes into an ADF task list
+func (c *markdownConverter) convertTaskList(list *gast.List) AtlassianContent {
+	result := AtlassianContent{Type: "taskList"}
+	result.Attrs.Other = map[string]interface{}{"localId": newLocalID()}
+
+	for item := list.FirstChild(); item != nil; item = item.NextSibling() {
+		task := AtlassianContent{Type: "taskItem"}
+		task.Attrs.State = "TODO"
+		task.Attrs.Other = map[string]interface{}{"localId": newLocalID()}
+
+		for block := item.FirstChild(); block != nil; block = block.NextSibling() {
+			switch block.(type) {
+			case *gast.Paragraph, *gast.TextBlock:
+				var inlines []AtlassianContent
+				for node := block.FirstChild(); node != nil; node = node.NextSibling() {
+					if checkbox, ok := node.(*east.TaskCheckBox); ok {
+						if checkbox.IsChecked {
+							task.Attrs.State = "DONE"
+						}
+						continue
+					}
+					inlines = appendInlines(inlines, c.convertInline(node, nil)...)
+				}
+				if len(task.Content) > 0 && len(inlines) > 0 {
+					task.Content = append(task.Content, AtlassianContent{Type: "hardBreak"})
+				}
+				task.Content = append(task.Content, inlines...)
+			}
+		}
+		result.Content = append(result.Content, task)
+	}
+	return result
+}
+
+// convertTable converts a GFM table; the header row uses tableHeader cells
+func (c *markdownConverter) convertTable(table *east.Table) AtlassianContent {
+	result := AtlassianContent{Type: "table"}
+	for row := table.FirstChild(); row != nil; row = row.NextSibling() {
+		cellType := "tableCell"
+		if _, ok := row.(*east.TableHeader); ok {
+			cellType = "tableHeader"
+		}
+
+		tableRow := AtlassianContent{Type: "tableRow"}
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			tableRow.Content = append(tableRow.Content, AtlassianContent{
+				Type:    cellType,
+				Content: []AtlassianContent{paragraph(c.convertInlines(cell, nil))},
+			})
+		}
+		result.Content = append(result.Content, tableRow)
+	}
+	return result
+}
+
+// convertInlines converts the inline children of a node, applying the given marks
+func (c *markdownConverter) convertInlines(parent gast.Node, marks []AtlassianMark) []AtlassianContent {
+	var inlines []AtlassianContent
+	for node := parent.FirstChild(); node != nil; node = node.NextSibling() {
+		inlines = appendInlines(inlines, c.convertInline(node, marks)...)
+	}
+	return inlines
+}
+
+// convertInline converts a single inline node
+func (c *markdownConverter) convertInline(node gast.Node, marks []AtlassianMark) []AtlassianContent {
+	switch n := node.(type) {
+	case *gast.Text:
+		value := c.unescape(n.Segment.Value(c.source), n.IsRaw())
+		nodes := []AtlassianContent{textNode(value, marks)}
+		if n.HardLineBreak() {
+			nodes = append(nodes, AtlassianContent{Type: "hardBreak"})
+		} else if n.SoftLineBreak() {
+			nodes = append(nodes, textNode(" ", marks))
+		}
+		return nodes
+	case *gast.String:
+		return []AtlassianContent{textNode(c.unescape(n.Value, n.IsRaw()), marks)}
+	case *gast.CodeSpan:
+		var code strings.Builder
+		for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+			if t, ok := child.(*gast.Text); ok {
+				code.Write(t.Segment.Value(c.source))
+			}
+		}
+		// The code mark may only be combined with links
+		var codeMarks []AtlassianMark
+		for _, mark := range marks {
+			if mark.Type == "link" {
+				codeMarks = append(codeMarks, mark)
+			}
+		}
+		return []AtlassianContent{textNode(code.String(), withMark(codeMarks, AtlassianMark{Type: "code"}))}
+	case *gast.Emphasis:
+		markType := "em"
+		if n.Level >= 2 {
+			markType = "strong"
+		}
+		return c.convertInlines(n, withMark(marks, AtlassianMark{Type: markType}))
+	case *east.Strikethrough:
+		return c.convertInlines(n, withMark(marks, AtlassianMark{Type: "strike"}))
+	case *gast.Link:
+		return c.convertInlines(n, withMark(marks, linkMark(string(n.Destination))))
+	case *gast.AutoLink:
+		url := string(n.URL(c.source))
+		return []AtlassianContent{textNode(string(n.Label(c.source)), withMark(marks, linkMark(url)))}
+	case *gast.Image:
+		// Inline images cannot be embedded in a paragraph, so link to them instead
+		label := strings.TrimSpace(string(n.Text(c.source)))
+		if label == "" {
+			label = string(n.Destination)
+		}
+		return []AtlassianContent{textNode(label, withMark(marks, linkMark(string(n.Destination))))}
+	case *gast.RawHTML:
+		var raw strings.Builder
+		for i := 0; i < n.Segments.Len(); i++ {
+			segment := n.Segments.At(i)
+			raw.Write(segment.Value(c.source))
+		}
+		return []AtlassianContent{textNode(raw.String(), marks)}
+	case *east.TaskCheckBox:
+		// Task lists consume their checkboxes; keep them visible in mixed lists
+		if n.IsChecked {
+			return []AtlassianContent{textNode("[x] ", marks)}
+		}
+		return []AtlassianContent{textNode("[ ] ", marks)}
+	default:
+		return c.convertInlines(n, marks)
+	}
+}
+
+// lines returns the raw source lines of a block node
+func (c *markdownConverter) lines(node gast.Node) string {
+	var buf strings.Builder
+	lines := node.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		buf.Write(segment.Value(c.source))
+	}
+	return buf.String()
+}
+
+// unescape resolves backslash escapes and character references in text
+func (c *markdownConverter) unescape(value []byte, raw bool) string {
+	if raw {
+		return string(value)
+	}
+	value = util.UnescapePunctuations(value)
+	value = util.ResolveNumericReferences(value)
+	value = util.ResolveEntityNames(value)
+	return string(value)
+}
+
+// paragraph creates a paragraph node
+func paragraph(content []AtlassianContent) AtlassianContent {
+	return AtlassianContent{
+		Type:    "paragraph",
+		Content: content,
+	}
+}
+
+// mediaSingle creates a media block showing an external image
+func mediaSingle(url string) AtlassianContent {
+	media := AtlassianContent{Type: "media"}
+	media.Attrs.URL = url
+	media.Attrs.Other = map[string]interface{}{"type": "external"}
+
+	block := AtlassianContent{
+		Type:    "mediaSingle",
+		Content: []AtlassianContent{media},
+	}
+	block.Attrs.Other = map[string]interface{}{"layout": "center"}
+	return block
+}
+
+// textNode creates a text node with the given marks
+func textNode(value string, marks []AtlassianMark) AtlassianContent {
+	return AtlassianContent{
+		Type:  "text",
+		Text:  value,
+		Marks: marks,
+	}
+}
+
+// linkMark creates a link mark pointing to url
+func linkMark(url string) AtlassianMark {
+	mark := AtlassianMark{Type: "link"}
+	mark.Attrs.Href = url
+	return mark
+}
+
+// withMark returns a copy of marks with mark appended
+func withMark(marks []AtlassianMark, mark AtlassianMark) []AtlassianMark {
+	result := make([]AtlassianMark, 0, len(marks)+1)
+	result = append(result, marks...)
+	return append(result, mark)
+}
+
+// appendInlines appends inline nodes, dropping empty text and merging
+// neighbouring text nodes that carry the same marks
+func appendInlines(inlines []AtlassianContent, nodes ...AtlassianContent) []AtlassianContent {
+	for _, node := range nodes {
+		if node.Type == "text" && node.Text == "" {
+			continue
+		}
+		if n := len(inlines); n > 0 && node.Type == "text" && inlines[n-1].Type == "text" && sameMarks(inlines[n-1].Marks, node.Marks) {
+			inlines[n-1].Text += node.Text
+			continue
+		}
+		inlines = append(inlines, node)
+	}
+	return inlines
+}
+
+// sameMarks reports whether two mark lists are identical
+func sameMarks(a, b []AtlassianMark) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// newLocalID returns a random identifier for task lists and task items
+func newLocalID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("%x", buf)
+	}
+	return hex.EncodeToString(buf)
+}
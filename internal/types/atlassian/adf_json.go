@@ -0,0 +1,136 @@
+package atlassian
+
+import (
+	"encoding/json"
+)
+
+// knownAttributes lists the JSON keys mapped to fields of AtlassianAttributes
+var knownAttributes = map[string]bool{
+	"level": true, "text": true, "title": true, "extensionType": true, "extensionKey": true,
+	"parameters": true, "url": true, "referencePageId": true, "referenceStatus": true,
+	"referencePageTitle": true, "color": true, "panelType": true, "state": true,
+	"language": true, "timestamp": true, "mediaWidth": true, "mediaHeight": true,
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Attributes without a dedicated field are kept in Other so documents survive a round trip.
+func (a *AtlassianAttributes) UnmarshalJSON(data []byte) error {
+	// Unmarshal the known fields through an alias to avoid recursion
+	type Alias AtlassianAttributes
+	aux := (*Alias)(a)
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	// Store any unhandled fields in Other
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	a.Other = nil
+	for k, v := range raw {
+		if knownAttributes[k] {
+			continue
+		}
+		if a.Other == nil {
+			a.Other = make(map[string]interface{})
+		}
+		a.Other[k] = v
+	}
+
+	return nil
+}
+
+// attributesMap returns the attributes as a map, merging the fields in Other
+func (a AtlassianAttributes) attributesMap() (map[string]interface{}, error) {
+	type Alias AtlassianAttributes
+	data, err := json.Marshal(Alias(a))
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := make(map[string]interface{})
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, err
+	}
+	for k, v := range a.Other {
+		if _, ok := attrs[k]; !ok {
+			attrs[k] = v
+		}
+	}
+	return attrs, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, including the fields kept in Other
+func (a AtlassianAttributes) MarshalJSON() ([]byte, error) {
+	attrs, err := a.attributesMap()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(attrs)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Empty attributes are omitted because the ADF schema rejects them on nodes such as text.
+func (content AtlassianContent) MarshalJSON() ([]byte, error) {
+	node := map[string]interface{}{
+		"type": content.Type,
+	}
+	if len(content.Content) > 0 {
+		node["content"] = content.Content
+	}
+	if content.Text != "" {
+		node["text"] = content.Text
+	}
+	if len(content.Marks) > 0 {
+		node["marks"] = content.Marks
+	}
+
+	attrs, err := content.Attrs.attributesMap()
+	if err != nil {
+		return nil, err
+	}
+	if len(attrs) > 0 {
+		node["attrs"] = attrs
+	}
+
+	return json.Marshal(node)
+}
+
+// MarshalJSON implements the json.Marshaler interface, omitting empty attributes
+func (mark AtlassianMark) MarshalJSON() ([]byte, error) {
+	node := map[string]interface{}{
+		"type": mark.Type,
+	}
+
+	attrs := make(map[string]string)
+	if mark.Attrs.URL != "" {
+		attrs["url"] = mark.Attrs.URL
+	}
+	if mark.Attrs.Href != "" {
+		attrs["href"] = mark.Attrs.Href
+	}
+	if mark.Attrs.Color != "" {
+		attrs["color"] = mark.Attrs.Color
+	}
+	if len(attrs) > 0 {
+		node["attrs"] = attrs
+	}
+
+	return json.Marshal(node)
+}
+
+// AtlassianDocumentToJSON serializes the document to an ADF JSON string
+func (doc *AtlassianDocument) AtlassianDocumentToJSON() (string, error) {
+	if doc.Version == 0 {
+		doc.Version = 1
+	}
+	if doc.Content == nil {
+		doc.Content = []AtlassianContent{}
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
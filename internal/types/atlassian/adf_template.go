@@ -0,0 +1,44 @@
+package atlassian
+
+import (
+	"markcli/internal/util"
+)
+
+// AtlassianDocumentExpandTemplate fills in a template document. Placeholder nodes whose
+// instruction text matches a variable name are replaced with the value, and {{name}}
+// variables inside text nodes are expanded. The names of placeholders and variables
+// left without a value are returned.
+func (doc *AtlassianDocument) AtlassianDocumentExpandTemplate(vars map[string]string) []string {
+	var missing []string
+	doc.Content = expandTemplateNodes(doc.Content, vars, &missing)
+	return missing
+}
+
+// expandTemplateNodes expands placeholders and variables in a list of nodes and their children.
+// Text left empty by an expansion is dropped, since ADF does not allow empty text nodes.
+func expandTemplateNodes(nodes []AtlassianContent, vars map[string]string, missing *[]string) []AtlassianContent {
+	expanded := make([]AtlassianContent, 0, len(nodes))
+	for _, node := range nodes {
+		switch node.Type {
+		case "placeholder":
+			value, ok := util.LookupVariable(vars, node.Attrs.Text)
+			if !ok {
+				*missing = append(*missing, node.Attrs.Text)
+				expanded = append(expanded, node)
+				continue
+			}
+			node = AtlassianContent{Type: "text", Text: value}
+		case "text":
+			var names []string
+			node.Text, names = util.ExpandTemplateVariables(node.Text, vars)
+			*missing = append(*missing, names...)
+		default:
+			node.Content = expandTemplateNodes(node.Content, vars, missing)
+		}
+		if node.Type == "text" && node.Text == "" {
+			continue
+		}
+		expanded = append(expanded, node)
+	}
+	return expanded
+}
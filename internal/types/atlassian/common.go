@@ -35,6 +35,7 @@ type AtlassianMark struct {
 	Type  string `json:"type"`
 	Attrs struct {
 		URL   string `json:"url,omitempty"`
+		Href  string `json:"href,omitempty"`
 		Color string `json:"color,omitempty"`
 	} `json:"attrs,omitempty"`
 }
@@ -89,3 +90,15 @@ type AtlassianVersion struct {
 	CreatedAt time.Time       `json:"createdAt,omitempty"`
 	Author    AtlassianAuthor `json:"author,omitempty"`
 }
+
+// AtlassianReference is a parsed reference to Confluence content or a Jira issue,
+// taken from a web URL, a tiny link, a page ID, an issue key or a SPACE:Title pair
+type AtlassianReference struct {
+	Product     string // "confluence" or "jira"
+	Host        string // Host of the URL the reference was taken from, if any
+	PageID      string // Confluence content ID, when known
+	ContentType string // Confluence content type of a space link ("page", "blogpost", "whiteboard", ...), if known
+	SpaceKey    string // Confluence space key of a title reference
+	Title       string // Confluence page title of a title reference
+	IssueKey    string // Jira issue key
+}
@@ -8,12 +8,19 @@ import (
 // AtlassianConfluenceSearchOptions represents options for searching pages in Confluence.
 // These options are used to filter and paginate search results.
 type AtlassianConfluenceSearchOptions struct {
-	Query     string `json:"query"`     // The search query text
-	SpaceKey  string `json:"spaceKey"`  // Optional space key to limit search to
-	StartAt   int    `json:"startAt"`   // Pagination start index (0-based)
-	Limit     int    `json:"limit"`     // Maximum number of results to return
-	SortBy    string `json:"sortBy"`    // Field to sort by (e.g., "modified", "created")
-	SortOrder string `json:"sortOrder"` // Sort direction ("asc" or "desc")
+	Query         string   `json:"query"`         // The search query text
+	SpaceKey      string   `json:"spaceKey"`      // Optional space key to limit search to
+	StartAt       int      `json:"startAt"`       // Pagination start index (0-based)
+	Limit         int      `json:"limit"`         // Maximum number of results to return
+	SortBy        string   `json:"sortBy"`        // Field to sort by (e.g., "modified", "created")
+	SortOrder     string   `json:"sortOrder"`     // Sort direction ("asc" or "desc")
+	Labels        []string `json:"labels"`        // Optional labels the content must carry
+	CQL           string   `json:"cql"`           // Raw CQL query; when set, all other filters are ignored
+	Types         []string `json:"types"`         // Content types to search (defaults to page)
+	Title         string   `json:"title"`         // Optional text the title must contain
+	Contributor   string   `json:"contributor"`   // Optional contributor account ID, or "me"
+	ModifiedSince string   `json:"modifiedSince"` // Optional date (YYYY-MM-DD) or relative duration (e.g. 7d)
+	Ancestor      string   `json:"ancestor"`      // Optional ID of a page the content must be below
 }
 
 // AtlassianConfluenceLinks represents common link attributes in Confluence responses
@@ -60,12 +67,15 @@ type AtlassianConfluenceBody struct {
 
 // AtlassianConfluenceContent represents the content structure in search results
 type AtlassianConfluenceContent struct {
-	ID     string                   `json:"id"`
-	Type   string                   `json:"type"`
-	Status string                   `json:"status"`
-	Title  string                   `json:"title"`
-	Body   AtlassianConfluenceBody  `json:"body,omitempty"`
-	Links  AtlassianConfluenceLinks `json:"_links,omitempty"`
+	ID       string                  `json:"id"`
+	Type     string                  `json:"type"`
+	Status   string                  `json:"status"`
+	Title    string                  `json:"title"`
+	Body     AtlassianConfluenceBody `json:"body,omitempty"`
+	Metadata struct {
+		Labels AtlassianConfluenceLabelsResponse `json:"labels,omitempty"`
+	} `json:"metadata,omitempty"`
+	Links AtlassianConfluenceLinks `json:"_links,omitempty"`
 }
 
 // AtlassianConfluenceResultContainer represents the global container in search results.
@@ -108,6 +118,68 @@ type AtlassianConfluenceSpace struct {
 	Status string `json:"status"` // Status of the space (e.g., "current", "archived")
 }
 
+// AtlassianConfluenceSpaceDetails represents a Confluence space as returned by the v2 API
+type AtlassianConfluenceSpaceDetails struct {
+	ID          string    `json:"id"`                     // Unique identifier used by the v2 API
+	Key         string    `json:"key"`                    // Space key (e.g., "TEAM")
+	Name        string    `json:"name"`                   // Display name of the space
+	Type        string    `json:"type"`                   // Type of space (e.g., "global", "personal")
+	Status      string    `json:"status"`                 // Status of the space (e.g., "current", "archived")
+	HomepageID  string    `json:"homepageId,omitempty"`   // ID of the space homepage
+	AuthorID    string    `json:"authorId,omitempty"`     // Account ID of the user who created the space
+	OwnerID     string    `json:"spaceOwnerId,omitempty"` // Account ID of the space owner, if one is set
+	CreatedAt   time.Time `json:"createdAt,omitempty"`    // Timestamp when the space was created
+	Description struct {
+		Plain struct {
+			Value string `json:"value"`
+		} `json:"plain,omitempty"`
+	} `json:"description,omitempty"`
+	Links AtlassianConfluenceLinks `json:"_links,omitempty"`
+}
+
+// AtlassianConfluenceSpacesResponse represents a page of results from the v2 spaces API
+type AtlassianConfluenceSpacesResponse struct {
+	Results []AtlassianConfluenceSpaceDetails `json:"results"`
+	Links   AtlassianConfluenceLinks          `json:"_links"`
+}
+
+// AtlassianConfluenceLabel represents a label attached to Confluence content
+type AtlassianConfluenceLabel struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Prefix string `json:"prefix"` // Label namespace, usually "global"
+}
+
+// AtlassianConfluenceLabelsResponse represents a page of labels.
+// Both the v1 content label API and the v2 labels API use this shape.
+type AtlassianConfluenceLabelsResponse struct {
+	Results []AtlassianConfluenceLabel `json:"results"`
+	Size    int                        `json:"size,omitempty"`
+	Links   AtlassianConfluenceLinks   `json:"_links"`
+}
+
+// AtlassianConfluenceLabelNames returns the names of the given labels
+func AtlassianConfluenceLabelNames(labels []AtlassianConfluenceLabel) []string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
+// AtlassianConfluencePageFrontmatter represents the YAML frontmatter written when exporting a page
+type AtlassianConfluencePageFrontmatter struct {
+	ID       string    `yaml:"id"`
+	Title    string    `yaml:"title"`
+	Space    string    `yaml:"space,omitempty"`
+	Version  int       `yaml:"version,omitempty"`
+	Updated  time.Time `yaml:"updated,omitempty"`
+	URL      string    `yaml:"url,omitempty"`
+	Labels   []string  `yaml:"labels,omitempty"`
+	ParentID string    `yaml:"parent_id,omitempty"`
+	Type     string    `yaml:"type,omitempty"` // "blogpost" for blog posts, empty for pages
+}
+
 // AtlassianConfluencePage represents a Confluence page.
 // It contains all the metadata and content of a page.
 type AtlassianConfluencePage struct {
@@ -129,21 +201,53 @@ type AtlassianConfluenceAuthor struct {
 	DisplayName string `json:"displayName"`
 }
 
-// AtlassianConfluenceFooterComment represents a Confluence footer comment.
+// AtlassianConfluenceComment represents a footer or inline comment on a Confluence page.
 // Comments can be in either storage format (legacy) or atlas_doc_format.
-type AtlassianConfluenceFooterComment struct {
-	ID      string                     `json:"id"`
-	Status  string                     `json:"status"`
-	Title   string                     `json:"title"`
-	Version AtlassianConfluenceVersion `json:"version"`
-	Body    AtlassianConfluenceBody    `json:"body"`
+type AtlassianConfluenceComment struct {
+	ID               string                       `json:"id"`
+	Status           string                       `json:"status"`
+	Title            string                       `json:"title"`
+	PageID           string                       `json:"pageId,omitempty"`
+	ParentCommentID  string                       `json:"parentCommentId,omitempty"`
+	Version          AtlassianConfluenceVersion   `json:"version"`
+	Body             AtlassianConfluenceBody      `json:"body"`
+	ResolutionStatus string                       `json:"resolutionStatus,omitempty"` // Inline comments only: open, reopened, resolved or dangling
+	Properties       AtlassianConfluenceInlineRef `json:"properties,omitempty"`       // Inline comments only: the anchored text selection
+	Links            AtlassianConfluenceLinks     `json:"_links,omitempty"`
+	Inline           bool                         `json:"-"` // Whether this is an inline comment
+	Replies          []AtlassianConfluenceComment `json:"-"` // Nested replies, oldest first
+	RepliesTruncated bool                         `json:"-"` // Whether replies were not fetched because a limit was reached
+}
+
+// AtlassianConfluenceInlineRef describes the text an inline comment is anchored to
+type AtlassianConfluenceInlineRef struct {
+	InlineMarkerRef         string `json:"inlineMarkerRef,omitempty"`         // ID of the annotation mark in the page body
+	InlineOriginalSelection string `json:"inlineOriginalSelection,omitempty"` // Text that was selected when the comment was made
 }
 
-// AtlassianConfluenceFooterCommentsResponse represents the response from the footer comments API.
+// AtlassianConfluenceCommentsResponse represents a page of results from the v2 comments APIs.
 // It contains a list of comments and pagination links.
-type AtlassianConfluenceFooterCommentsResponse struct {
-	Results []AtlassianConfluenceFooterComment `json:"results"`
-	Links   AtlassianConfluenceLinks           `json:"_links"`
+type AtlassianConfluenceCommentsResponse struct {
+	Results []AtlassianConfluenceComment `json:"results"`
+	Links   AtlassianConfluenceLinks     `json:"_links"`
+}
+
+// AtlassianConfluenceCommentBody represents the body of a comment being created or updated
+type AtlassianConfluenceCommentBody struct {
+	Representation string `json:"representation"`
+	Value          string `json:"value"`
+}
+
+// AtlassianConfluenceCreateCommentOptions represents the options for creating a comment or reply.
+// Set PageID for a new top-level comment, or ParentCommentID for a reply.
+type AtlassianConfluenceCreateCommentOptions struct {
+	PageID          string // Page to comment on
+	ParentCommentID string // Comment to reply to
+	Inline          bool   // Whether to create an inline comment
+	Body            string // Comment body as ADF JSON
+	TextSelection   string // Inline comments only: text to anchor the comment to
+	MatchCount      int    // Inline comments only: number of occurrences of TextSelection in the page
+	MatchIndex      int    // Inline comments only: which occurrence to anchor to (0-based)
 }
 
 // AtlassianConfluenceMacroValue represents a macro value in Confluence.
@@ -202,11 +306,16 @@ type AtlassianConfluencePageDetails struct {
 			Value string `json:"value"`
 		} `json:"atlas_doc_format"`
 	} `json:"body"`
-	SpaceId string `json:"spaceId"`
-	Links   struct {
+	SpaceId   string    `json:"spaceId"`
+	ParentId  string    `json:"parentId,omitempty"`
+	AuthorID  string    `json:"authorId,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	Links     struct {
 		WebUI string `json:"webui"`
 	} `json:"_links"`
-	Comments *AtlassianConfluenceFooterCommentsResponse `json:"comments,omitempty"`
+	Type           string                       `json:"-"` // "blogpost" for blog posts, empty for pages
+	FooterComments []AtlassianConfluenceComment `json:"-"`
+	InlineComments []AtlassianConfluenceComment `json:"-"`
 }
 
 // AtlassianConfluenceError represents an error response from the Confluence API
@@ -233,3 +342,280 @@ func (e *AtlassianConfluenceError) Error() string {
 	}
 	return fmt.Sprintf("Confluence API error: status code %d", e.StatusCode)
 }
+
+// AtlassianConfluencePageVersion represents a single entry in a page's version history
+type AtlassianConfluencePageVersion struct {
+	Number    int                       `json:"number"`           // Version number
+	Message   string                    `json:"message"`          // Message entered when the version was saved
+	MinorEdit bool                      `json:"minorEdit"`        // Whether this was a minor edit
+	AuthorID  string                    `json:"authorId"`         // Account ID of the user who saved the version
+	CreatedAt time.Time                 `json:"createdAt"`        // Timestamp when the version was saved
+	Author    AtlassianConfluenceAuthor `json:"author,omitempty"` // Resolved author details
+}
+
+// AtlassianConfluencePageVersionsResponse represents a page of results from the page versions API
+type AtlassianConfluencePageVersionsResponse struct {
+	Results []AtlassianConfluencePageVersion `json:"results"`
+	Links   AtlassianConfluenceLinks         `json:"_links"`
+}
+
+// AtlassianConfluenceUser represents a Confluence user as returned by the user API
+type AtlassianConfluenceUser struct {
+	AccountID   string `json:"accountId"`
+	AccountType string `json:"accountType,omitempty"`
+	Email       string `json:"email,omitempty"`
+	PublicName  string `json:"publicName,omitempty"`
+	DisplayName string `json:"displayName"`
+}
+
+// AtlassianConfluenceAttachment represents a file attached to a Confluence page
+type AtlassianConfluenceAttachment struct {
+	ID           string                     `json:"id"`
+	Status       string                     `json:"status"`
+	Title        string                     `json:"title"`        // File name of the attachment
+	CreatedAt    time.Time                  `json:"createdAt"`    // Timestamp of the current version
+	PageID       string                     `json:"pageId"`       // Page the attachment belongs to
+	MediaType    string                     `json:"mediaType"`    // MIME type, e.g. image/png
+	Comment      string                     `json:"comment"`      // Comment entered when the file was uploaded
+	FileID       string                     `json:"fileId"`       // Media services file ID, referenced by ADF media nodes
+	FileSize     int64                      `json:"fileSize"`     // Size in bytes
+	WebUILink    string                     `json:"webuiLink"`    // Link to the attachment preview, relative to /wiki
+	DownloadLink string                     `json:"downloadLink"` // Link to the file content, relative to /wiki
+	Version      AtlassianConfluenceVersion `json:"version"`
+}
+
+// AtlassianConfluenceAttachmentsResponse represents a page of results from the attachments API
+type AtlassianConfluenceAttachmentsResponse struct {
+	Results []AtlassianConfluenceAttachment `json:"results"`
+	Links   AtlassianConfluenceLinks        `json:"_links"`
+}
+
+// AtlassianConfluencePageNode represents a page in a page tree, with its descendants
+type AtlassianConfluencePageNode struct {
+	ID       string                        `json:"id"`
+	Title    string                        `json:"title"`
+	Status   string                        `json:"status"`
+	SpaceID  string                        `json:"spaceId,omitempty"`
+	ParentID string                        `json:"parentId,omitempty"`
+	Children []AtlassianConfluencePageNode `json:"-"`
+}
+
+// AtlassianConfluencePageTreeIDs returns the IDs of a page and all of its descendants, parents first
+func AtlassianConfluencePageTreeIDs(node AtlassianConfluencePageNode) []string {
+	ids := []string{node.ID}
+	for _, child := range node.Children {
+		ids = append(ids, AtlassianConfluencePageTreeIDs(child)...)
+	}
+	return ids
+}
+
+// AtlassianConfluencePageNodesResponse represents a page of results from the v2 children and ancestors APIs
+type AtlassianConfluencePageNodesResponse struct {
+	Results []AtlassianConfluencePageNode `json:"results"`
+	Links   AtlassianConfluenceLinks      `json:"_links"`
+}
+
+// AtlassianConfluenceLongTask represents the status of an asynchronous Confluence operation,
+// such as a page hierarchy copy or an archive request
+type AtlassianConfluenceLongTask struct {
+	ID                 string `json:"id"`
+	PercentageComplete int    `json:"percentageComplete"`
+	Successful         bool   `json:"successful"`
+	Finished           bool   `json:"finished"`
+	Messages           []struct {
+		Translation string `json:"translation"`
+	} `json:"messages"`
+}
+
+// AtlassianConfluenceCopyPageOptions represents options for copying a page
+type AtlassianConfluenceCopyPageOptions struct {
+	PageID      string // Page to copy
+	ParentID    string // Page to copy under
+	Title       string // Title of the copy; only used for single page copies
+	TitlePrefix string // Prefix added to the title of every copied page
+	Recursive   bool   // Copy the page together with all of its descendants
+}
+
+// AtlassianConfluenceTemplate represents a page template or blueprint template
+type AtlassianConfluenceTemplate struct {
+	TemplateID   string                     `json:"templateId"`
+	Name         string                     `json:"name"`
+	Description  string                     `json:"description"`
+	TemplateType string                     `json:"templateType"` // "page" for space and global templates
+	Labels       []AtlassianConfluenceLabel `json:"labels"`
+	Space        *struct {
+		Key string `json:"key"`
+	} `json:"space,omitempty"` // Space the template belongs to; nil for global templates
+	Body AtlassianConfluenceBody `json:"body,omitempty"`
+}
+
+// AtlassianConfluenceTemplatesResponse represents a page of results from the template API
+type AtlassianConfluenceTemplatesResponse struct {
+	Results []AtlassianConfluenceTemplate `json:"results"`
+	Start   int                           `json:"start"`
+	Limit   int                           `json:"limit"`
+	Size    int                           `json:"size"`
+}
+
+// AtlassianConfluenceCreatePageOptions represents options for creating a page
+type AtlassianConfluenceCreatePageOptions struct {
+	SpaceID        string // v2 ID of the space to create the page in
+	ParentID       string // Optional parent page; defaults to the space homepage
+	Title          string
+	Status         string // "current" to publish, or "draft"
+	Body           string // Page body in the given representation
+	Representation string // "atlas_doc_format" or "storage"
+}
+
+// AtlassianConfluenceBlogPostsResponse represents a page of results from the v2 blog posts API
+type AtlassianConfluenceBlogPostsResponse struct {
+	Results []AtlassianConfluencePageDetails `json:"results"`
+	Links   AtlassianConfluenceLinks         `json:"_links"`
+}
+
+// AtlassianConfluenceSpacePermission grants one operation in a space to a user, group, or role
+type AtlassianConfluenceSpacePermission struct {
+	ID        string `json:"id"`
+	Principal struct {
+		Type string `json:"type"` // "user", "group", or "role"
+		ID   string `json:"id"`
+	} `json:"principal"`
+	Operation struct {
+		Key        string `json:"key"`        // e.g. "read", "create", "delete", "administer"
+		TargetType string `json:"targetType"` // e.g. "space", "page", "blogpost", "comment"
+	} `json:"operation"`
+}
+
+// AtlassianConfluenceSpacePermissionsResponse represents a page of results from the v2 space permissions API
+type AtlassianConfluenceSpacePermissionsResponse struct {
+	Results []AtlassianConfluenceSpacePermission `json:"results"`
+	Links   AtlassianConfluenceLinks             `json:"_links"`
+}
+
+// AtlassianConfluenceGroup represents a Confluence user group
+type AtlassianConfluenceGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// AtlassianConfluenceLabelCount is a label together with the number of items carrying it
+type AtlassianConfluenceLabelCount struct {
+	Name  string
+	Count int
+}
+
+// AtlassianConfluenceSpaceSummary collects what is shown about a single space
+type AtlassianConfluenceSpaceSummary struct {
+	Space         AtlassianConfluenceSpaceDetails
+	HomepageTitle string
+	OwnerName     string
+	Labels        []AtlassianConfluenceLabel      // Labels on the space itself
+	ContentLabels []AtlassianConfluenceLabelCount // Labels used on content in the space, most used first
+}
+
+// AtlassianConfluenceCreateSpaceOptions represents options for creating a space
+type AtlassianConfluenceCreateSpaceOptions struct {
+	Key         string
+	Name        string
+	Description string // Plain text description
+	Private     bool   // Create a space only visible to its creator
+}
+
+// AtlassianConfluenceRestriction lists the users and groups an operation on a page is restricted to.
+// An operation without users or groups is unrestricted.
+type AtlassianConfluenceRestriction struct {
+	Operation    string `json:"operation"` // "read" or "update"
+	Restrictions struct {
+		User struct {
+			Results []AtlassianConfluenceUser `json:"results"`
+		} `json:"user"`
+		Group struct {
+			Results []AtlassianConfluenceGroup `json:"results"`
+		} `json:"group"`
+	} `json:"restrictions"`
+}
+
+// Empty reports whether the operation is unrestricted
+func (r AtlassianConfluenceRestriction) Empty() bool {
+	return len(r.Restrictions.User.Results) == 0 && len(r.Restrictions.Group.Results) == 0
+}
+
+// Allows reports whether the restriction admits a user, either directly or through one of the given groups
+func (r AtlassianConfluenceRestriction) Allows(accountID string, groups []AtlassianConfluenceGroup) bool {
+	if r.Empty() {
+		return true
+	}
+	for _, user := range r.Restrictions.User.Results {
+		if user.AccountID == accountID {
+			return true
+		}
+	}
+	for _, restricted := range r.Restrictions.Group.Results {
+		for _, group := range groups {
+			if (restricted.ID != "" && restricted.ID == group.ID) || restricted.Name == group.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AtlassianConfluencePageRestrictions holds the restrictions set directly on one page
+type AtlassianConfluencePageRestrictions struct {
+	Page      AtlassianConfluencePageNode
+	Inherited bool // Set on an ancestor of the inspected page
+	Read      AtlassianConfluenceRestriction
+	Update    AtlassianConfluenceRestriction
+}
+
+// AtlassianConfluenceAccessCheck is the effective access of a user to a page
+type AtlassianConfluenceAccessCheck struct {
+	User    AtlassianConfluenceUser
+	CanView bool
+	CanEdit bool
+	Reasons []string // Why access is denied, or which restrictions grant it
+}
+
+// AtlassianConfluenceTask represents an inline task (action item) on a page or blog post
+type AtlassianConfluenceTask struct {
+	ID         string `json:"id"`
+	LocalID    string `json:"localId"`
+	SpaceID    string `json:"spaceId"`
+	PageID     string `json:"pageId,omitempty"`
+	BlogPostID string `json:"blogPostId,omitempty"`
+	Status     string `json:"status"` // "complete" or "incomplete"
+	Body       struct {
+		AtlasDocFormat struct {
+			Value string `json:"value"`
+		} `json:"atlas_doc_format"`
+	} `json:"body"`
+	CreatedBy   string    `json:"createdBy,omitempty"`   // Account ID of the creator
+	AssignedTo  string    `json:"assignedTo,omitempty"`  // Account ID of the assignee
+	CompletedBy string    `json:"completedBy,omitempty"` // Account ID of the user who completed the task
+	CreatedAt   time.Time `json:"createdAt,omitempty"`
+	DueAt       time.Time `json:"dueAt,omitempty"`
+	CompletedAt time.Time `json:"completedAt,omitempty"`
+}
+
+// AtlassianConfluenceTasksResponse represents a page of results from the v2 tasks API
+type AtlassianConfluenceTasksResponse struct {
+	Results []AtlassianConfluenceTask `json:"results"`
+	Links   AtlassianConfluenceLinks  `json:"_links"`
+}
+
+// AtlassianConfluenceTaskOptions represents filters for listing tasks
+type AtlassianConfluenceTaskOptions struct {
+	Status     string    // "complete" or "incomplete"; empty for both
+	AssigneeID string    // Account ID of the assignee
+	SpaceID    string    // v2 ID of the space
+	DueBefore  time.Time // Only tasks due before this time
+	Limit      int       // Maximum number of tasks; 0 for all
+}
+
+// AtlassianConfluenceTaskContent is a task together with the content it appears on
+type AtlassianConfluenceTaskContent struct {
+	Task         AtlassianConfluenceTask
+	AssigneeName string
+	ContentTitle string
+	ContentURL   string // Absolute URL of the page or blog post
+}
@@ -1,5 +1,12 @@
 package atlassian
 
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
 // AtlassianJiraProject represents a Jira project
 type AtlassianJiraProject struct {
 	ID             string `json:"id"`
@@ -11,18 +18,33 @@ type AtlassianJiraProject struct {
 
 // AtlassianJiraSearchOptions represents options for searching Jira issues
 type AtlassianJiraSearchOptions struct {
-	Query   string
-	StartAt int
-	Limit   int
+	Query         string
+	StartAt       int // Number of results to skip; ignored when NextPageToken is set
+	Limit         int
+	NextPageToken string   // Token of the page to fetch, from a previous response
+	Fields        []string // Field IDs to return besides the standard ones; "*all" returns every field
+}
+
+// AtlassianJiraSearchFilters represents the filters a JQL search query is built from
+type AtlassianJiraSearchFilters struct {
+	JQL          string   // Raw JQL query; when set, all other filters are ignored
+	Text         string   // Optional text to search summaries, descriptions, and comments for
+	Project      string   // Optional project key
+	Statuses     []string // Optional status names
+	Assignee     string   // Optional account ID, "me", or "none" for unassigned issues
+	Types        []string // Optional issue type names
+	Labels       []string // Optional labels the issue must carry
+	Sprint       string   // Optional sprint ID or name, or "current", "future", or "closed"
+	UpdatedSince string   // Optional date (YYYY-MM-DD) or relative duration (e.g. 7d)
+	CreatedSince string   // Optional date (YYYY-MM-DD) or relative duration (e.g. 7d)
+	OrderBy      string   // Sort clause such as "updated DESC" or "priority"
 }
 
-// AtlassianJiraSearchResponse represents a search response from the Jira API
+// AtlassianJiraSearchResponse represents a page of results from the Jira search API
 type AtlassianJiraSearchResponse struct {
-	Expand     string               `json:"expand"`
-	StartAt    int                  `json:"startAt"`
-	MaxResults int                  `json:"maxResults"`
-	Total      int                  `json:"total"`
-	Issues     []AtlassianJiraIssue `json:"issues"`
+	Issues        []AtlassianJiraIssue `json:"issues"`
+	NextPageToken string               `json:"nextPageToken,omitempty"`
+	IsLast        bool                 `json:"isLast"`
 }
 
 // AtlassianJiraIssue represents a Jira issue
@@ -38,7 +60,11 @@ type AtlassianJiraIssue struct {
 			Content []AtlassianContent `json:"content,omitempty"`
 		} `json:"description"`
 		Status struct {
-			Name string `json:"name"`
+			Name           string `json:"name"`
+			StatusCategory struct {
+				Key  string `json:"key"` // "new", "indeterminate", or "done"
+				Name string `json:"name"`
+			} `json:"statusCategory"`
 		} `json:"status"`
 		Priority struct {
 			Name string `json:"name"`
@@ -62,7 +88,39 @@ type AtlassianJiraIssue struct {
 		Reporter *struct {
 			DisplayName string `json:"displayName"`
 		} `json:"reporter"`
+		Parent      *AtlassianJiraLinkedIssue  `json:"parent,omitempty"`
+		Subtasks    []AtlassianJiraLinkedIssue `json:"subtasks,omitempty"`
+		IssueLinks  []AtlassianJiraIssueLink   `json:"issuelinks,omitempty"`
+		Attachments []AtlassianJiraAttachment  `json:"attachment,omitempty"`
 	} `json:"fields"`
+	// AllFields holds every returned field by ID, including custom fields
+	AllFields map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes an issue, keeping the raw value of every field in AllFields
+func (i *AtlassianJiraIssue) UnmarshalJSON(data []byte) error {
+	type plainIssue AtlassianJiraIssue
+	if err := json.Unmarshal(data, (*plainIssue)(i)); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	i.AllFields = raw.Fields
+	return nil
+}
+
+// FieldValue returns the raw value of a field by ID; ok is false for missing and empty fields
+func (i AtlassianJiraIssue) FieldValue(id string) (value json.RawMessage, ok bool) {
+	value, ok = i.AllFields[id]
+	if !ok || len(value) == 0 || string(value) == "null" {
+		return nil, false
+	}
+	return value, true
 }
 
 // AtlassianJiraError represents an error returned by the Jira API
@@ -78,9 +136,18 @@ func (e *AtlassianJiraError) Error() string {
 		return e.ErrorMessages[0]
 	}
 	if len(e.Errors) > 0 {
-		for _, v := range e.Errors {
-			return v
+		// Field errors are reported together, e.g. when creating or editing an issue
+		fields := make([]string, 0, len(e.Errors))
+		for field := range e.Errors {
+			fields = append(fields, field)
 		}
+		sort.Strings(fields)
+
+		messages := make([]string, 0, len(fields))
+		for _, field := range fields {
+			messages = append(messages, fmt.Sprintf("%s: %s", field, e.Errors[field]))
+		}
+		return strings.Join(messages, "; ")
 	}
 	return e.Message
 }
@@ -96,9 +163,31 @@ type AtlassianJiraComment struct {
 		Version int                `json:"version"`
 		Content []AtlassianContent `json:"content"`
 	} `json:"body"`
-	Created   string `json:"created"`
-	Updated   string `json:"updated"`
-	JSDPublic bool   `json:"jsdPublic"`
+	Created    string                   `json:"created"`
+	Updated    string                   `json:"updated"`
+	JSDPublic  *bool                    `json:"jsdPublic,omitempty"`
+	Visibility *AtlassianJiraVisibility `json:"visibility,omitempty"`
+}
+
+// Internal reports whether a Jira Service Management comment is hidden from customers
+func (c AtlassianJiraComment) Internal() bool {
+	return c.JSDPublic != nil && !*c.JSDPublic
+}
+
+// AtlassianJiraVisibility restricts a comment or worklog to a project role or group
+type AtlassianJiraVisibility struct {
+	Type       string `json:"type"` // "role" or "group"
+	Value      string `json:"value,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+}
+
+// AtlassianJiraCommentOptions holds the content of a comment to add or edit
+type AtlassianJiraCommentOptions struct {
+	Body       *AtlassianDocument
+	Visibility *AtlassianJiraVisibility
+	// Internal marks a Jira Service Management comment as internal (true) or
+	// shared with the customer (false); nil leaves the default
+	Internal *bool
 }
 
 // AtlassianJiraCommentsResponse represents a response containing Jira comments
@@ -108,3 +197,307 @@ type AtlassianJiraCommentsResponse struct {
 	MaxResults int                    `json:"maxResults"`
 	Total      int                    `json:"total"`
 }
+
+// AtlassianJiraUser represents a Jira user
+type AtlassianJiraUser struct {
+	AccountID    string `json:"accountId"`
+	AccountType  string `json:"accountType,omitempty"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress,omitempty"`
+	Active       bool   `json:"active"`
+}
+
+// AtlassianJiraIssueType represents an issue type available in a project
+type AtlassianJiraIssueType struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Subtask bool   `json:"subtask"`
+}
+
+// AtlassianJiraFieldSchema describes the type of values a field holds
+type AtlassianJiraFieldSchema struct {
+	Type     string `json:"type"`               // e.g. "string", "number", "user", "option", "array"
+	Items    string `json:"items,omitempty"`    // Item type of array fields
+	System   string `json:"system,omitempty"`   // Name of a system field, e.g. "labels"
+	Custom   string `json:"custom,omitempty"`   // Plugin key of a custom field type
+	CustomID int    `json:"customId,omitempty"` // Numeric ID of a custom field
+}
+
+// AtlassianJiraAllowedValue is one of the values a field accepts.
+// Options carry a value, while priorities, components, and versions carry a name.
+type AtlassianJiraAllowedValue struct {
+	ID       string                      `json:"id"`
+	Name     string                      `json:"name,omitempty"`
+	Value    string                      `json:"value,omitempty"`
+	Key      string                      `json:"key,omitempty"`
+	Children []AtlassianJiraAllowedValue `json:"children,omitempty"` // Child options of cascading selects
+}
+
+// Label returns the human readable name of an allowed value
+func (v AtlassianJiraAllowedValue) Label() string {
+	switch {
+	case v.Value != "":
+		return v.Value
+	case v.Name != "":
+		return v.Name
+	case v.Key != "":
+		return v.Key
+	}
+	return v.ID
+}
+
+// AtlassianJiraFieldMeta describes a field on a create or edit screen
+type AtlassianJiraFieldMeta struct {
+	FieldID         string                      `json:"fieldId"`
+	Key             string                      `json:"key"`
+	Name            string                      `json:"name"`
+	Required        bool                        `json:"required"`
+	HasDefaultValue bool                        `json:"hasDefaultValue"`
+	Schema          AtlassianJiraFieldSchema    `json:"schema"`
+	AllowedValues   []AtlassianJiraAllowedValue `json:"allowedValues,omitempty"`
+	Operations      []string                    `json:"operations,omitempty"` // e.g. "set", "add", "remove"
+}
+
+// ID returns the identifier used in issue payloads
+func (m AtlassianJiraFieldMeta) ID() string {
+	if m.FieldID != "" {
+		return m.FieldID
+	}
+	return m.Key
+}
+
+// AtlassianJiraIssueFrontmatter is the YAML header of a markdown file describing a new issue
+type AtlassianJiraIssueFrontmatter struct {
+	Project    string                 `yaml:"project,omitempty"`
+	Type       string                 `yaml:"type,omitempty"`
+	Summary    string                 `yaml:"summary,omitempty"`
+	Assignee   string                 `yaml:"assignee,omitempty"`
+	Labels     []string               `yaml:"labels,omitempty"`
+	Priority   string                 `yaml:"priority,omitempty"`
+	Parent     string                 `yaml:"parent,omitempty"`
+	Components []string               `yaml:"components,omitempty"`
+	Fields     map[string]interface{} `yaml:"fields,omitempty"` // Other fields by name or ID
+}
+
+// AtlassianJiraCreatedIssue is the response to creating an issue
+type AtlassianJiraCreatedIssue struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Self string `json:"self"`
+}
+
+// AtlassianJiraField represents a system or custom field in the site's field catalog
+type AtlassianJiraField struct {
+	ID          string                    `json:"id"`
+	Key         string                    `json:"key"`
+	Name        string                    `json:"name"`
+	Custom      bool                      `json:"custom"`
+	ClauseNames []string                  `json:"clauseNames,omitempty"` // Names usable in JQL
+	Schema      *AtlassianJiraFieldSchema `json:"schema,omitempty"`
+}
+
+// AtlassianJiraFieldChange is a single change requested on an issue field
+type AtlassianJiraFieldChange struct {
+	Operation string // "set", "add", or "remove"
+	Field     string // Field name or ID as given by the user
+	Value     string // Value in command-line form
+}
+
+// AtlassianJiraTransition is a workflow transition available on an issue
+type AtlassianJiraTransition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   struct {
+		ID             string `json:"id"`
+		Name           string `json:"name"`
+		StatusCategory struct {
+			Key  string `json:"key"`
+			Name string `json:"name"`
+		} `json:"statusCategory"`
+	} `json:"to"`
+	HasScreen bool                              `json:"hasScreen"`
+	Fields    map[string]AtlassianJiraFieldMeta `json:"fields,omitempty"` // Fields on the transition screen
+}
+
+// AtlassianJiraIssueResult reports the outcome of an operation on one issue of a bulk run
+type AtlassianJiraIssueResult struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary,omitempty"`
+	Change  string `json:"change,omitempty"`  // What was or would be changed
+	Status  string `json:"status"`            // "done", "failed", "skipped", or "dry run"
+	Message string `json:"message,omitempty"` // Error or reason for skipping
+}
+
+// AtlassianJiraLinkedIssue is the short form of an issue used for parents, subtasks, and links
+type AtlassianJiraLinkedIssue struct {
+	ID     string `json:"id"`
+	Key    string `json:"key"`
+	Fields struct {
+		Summary string `json:"summary"`
+		Status  struct {
+			Name           string `json:"name"`
+			StatusCategory struct {
+				Key string `json:"key"`
+			} `json:"statusCategory"`
+		} `json:"status"`
+		Priority struct {
+			Name string `json:"name"`
+		} `json:"priority"`
+		IssueType struct {
+			Name    string `json:"name"`
+			Subtask bool   `json:"subtask"`
+		} `json:"issuetype"`
+	} `json:"fields"`
+}
+
+// AtlassianJiraIssueLinkType describes a kind of link, e.g. "blocks" / "is blocked by"
+type AtlassianJiraIssueLinkType struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward,omitempty"`
+	Outward string `json:"outward,omitempty"`
+}
+
+// AtlassianJiraIssueLink is a link between two issues. Seen from an issue, only the other
+// end is set: OutwardIssue for "this issue <outward> other", InwardIssue for "this issue <inward> other".
+type AtlassianJiraIssueLink struct {
+	ID           string                     `json:"id"`
+	Type         AtlassianJiraIssueLinkType `json:"type"`
+	InwardIssue  *AtlassianJiraLinkedIssue  `json:"inwardIssue,omitempty"`
+	OutwardIssue *AtlassianJiraLinkedIssue  `json:"outwardIssue,omitempty"`
+}
+
+// Relation returns how the issue holding the link relates to the other issue, and the other issue
+func (l AtlassianJiraIssueLink) Relation() (string, *AtlassianJiraLinkedIssue) {
+	if l.OutwardIssue != nil {
+		return l.Type.Outward, l.OutwardIssue
+	}
+	return l.Type.Inward, l.InwardIssue
+}
+
+// AtlassianJiraIssueNode is an issue with its child issues, for hierarchy views
+type AtlassianJiraIssueNode struct {
+	Issue    AtlassianJiraIssue
+	Children []AtlassianJiraIssueNode
+}
+
+// AtlassianJiraBoard is a Scrum or Kanban board
+type AtlassianJiraBoard struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"` // "scrum", "kanban", or "simple"
+	Location *struct {
+		ProjectKey  string `json:"projectKey"`
+		ProjectName string `json:"projectName"`
+		DisplayName string `json:"displayName"`
+	} `json:"location,omitempty"`
+}
+
+// AtlassianJiraBoardConfiguration holds the board settings needed to show estimates
+type AtlassianJiraBoardConfiguration struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Estimation *struct {
+		Type  string `json:"type"` // "field" or "issueCount"
+		Field struct {
+			FieldID     string `json:"fieldId"`
+			DisplayName string `json:"displayName"`
+		} `json:"field"`
+	} `json:"estimation,omitempty"`
+}
+
+// AtlassianJiraSprint is a sprint of a Scrum board
+type AtlassianJiraSprint struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	State         string `json:"state"` // "future", "active", or "closed"
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	CompleteDate  string `json:"completeDate,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+	OriginBoardID int    `json:"originBoardId,omitempty"`
+}
+
+// AtlassianJiraChangelogItem is a change to a single field
+type AtlassianJiraChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// AtlassianJiraChangelog is a set of field changes made by one user at one time
+type AtlassianJiraChangelog struct {
+	ID     string `json:"id"`
+	Author *struct {
+		AccountID   string `json:"accountId"`
+		DisplayName string `json:"displayName"`
+	} `json:"author,omitempty"`
+	Created string                       `json:"created"`
+	Items   []AtlassianJiraChangelogItem `json:"items"`
+}
+
+// AtlassianJiraStatusPeriod is a stretch of time an issue spent in one status
+type AtlassianJiraStatusPeriod struct {
+	Status  string `json:"status"`
+	Entered string `json:"entered"`
+	Left    string `json:"left,omitempty"` // Empty for the current status
+	Seconds int64  `json:"durationSeconds"`
+	By      string `json:"by,omitempty"` // Who moved the issue into the status
+}
+
+// AtlassianJiraWorklog is time logged on an issue
+type AtlassianJiraWorklog struct {
+	ID      string `json:"id"`
+	IssueID string `json:"issueId"`
+	Author  *struct {
+		AccountID   string `json:"accountId"`
+		DisplayName string `json:"displayName"`
+	} `json:"author,omitempty"`
+	Comment          *AtlassianDocument `json:"comment,omitempty"`
+	Started          string             `json:"started"`
+	TimeSpent        string             `json:"timeSpent"`
+	TimeSpentSeconds int64              `json:"timeSpentSeconds"`
+}
+
+// AtlassianJiraTimesheetEntry is a worklog together with the issue it was logged on
+type AtlassianJiraTimesheetEntry struct {
+	IssueKey string
+	Summary  string
+	Worklog  AtlassianJiraWorklog
+}
+
+// AtlassianJiraAttachment is a file attached to an issue
+type AtlassianJiraAttachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Author   *struct {
+		AccountID   string `json:"accountId"`
+		DisplayName string `json:"displayName"`
+	} `json:"author,omitempty"`
+	Created   string `json:"created"`
+	Size      int64  `json:"size"`
+	MimeType  string `json:"mimeType"`
+	Content   string `json:"content"`             // URL of the file content
+	Thumbnail string `json:"thumbnail,omitempty"` // URL of a preview image, for images
+}
+
+// AtlassianJiraBulkJournal records what a bulk operation changed, so it can be undone
+type AtlassianJiraBulkJournal struct {
+	Operation string                          `json:"operation"` // "edit", "transition", or "comment"
+	Site      string                          `json:"site"`      // Base URL of the site the changes were made on
+	JQL       string                          `json:"jql"`
+	Created   string                          `json:"created"`
+	Entries   []AtlassianJiraBulkJournalEntry `json:"entries"`
+}
+
+// AtlassianJiraBulkJournalEntry holds what is needed to undo the change of one issue
+type AtlassianJiraBulkJournalEntry struct {
+	Key       string                     `json:"key"`
+	Fields    map[string]json.RawMessage `json:"fields,omitempty"`    // Previous values of edited fields, by ID
+	Status    string                     `json:"status,omitempty"`    // Previous status of a transitioned issue
+	CommentID string                     `json:"commentId,omitempty"` // Comment added to the issue
+}
@@ -0,0 +1,29 @@
+package util
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// ReadTextInput returns text if set, otherwise the contents of filePath ("-" reads stdin)
+func ReadTextInput(text, filePath string) (string, error) {
+	if text != "" && filePath != "" {
+		return "", fmt.Errorf("use either --text or --file, not both")
+	}
+	if filePath == "" {
+		return text, nil
+	}
+
+	var data []byte
+	var err error
+	if filePath == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(filePath)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Confirm asks a yes/no question on stderr and reads the answer from stdin.
+// Anything other than "y" or "yes" counts as no, including end of input.
+func Confirm(question string) bool {
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", question)
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(os.Stderr)
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
+
+// Prompt asks a question on stderr and returns the trimmed line read from stdin.
+// The second result is false when stdin is not a terminal or input has ended.
+func Prompt(question string) (string, bool) {
+	info, err := os.Stdin.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return "", false
+	}
+
+	fmt.Fprintf(os.Stderr, "%s: ", question)
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(os.Stderr)
+		return "", false
+	}
+	return strings.TrimSpace(answer), true
+}
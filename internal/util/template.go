@@ -0,0 +1,54 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// templateVariablePattern matches {{name}} variables, allowing spaces inside the braces
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+// ExpandTemplateVariables replaces {{name}} variables in text with their values.
+// Variable names are matched case-insensitively. Variables without a value are left
+// in place and their names are returned.
+func ExpandTemplateVariables(text string, vars map[string]string) (string, []string) {
+	var missing []string
+	expanded := templateVariablePattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := templateVariablePattern.FindStringSubmatch(match)[1]
+		if value, ok := LookupVariable(vars, name); ok {
+			return value
+		}
+		missing = append(missing, name)
+		return match
+	})
+	return expanded, missing
+}
+
+// LookupVariable finds a variable by name, ignoring case and surrounding whitespace
+func LookupVariable(vars map[string]string, name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if value, ok := vars[name]; ok {
+		return value, true
+	}
+	for key, value := range vars {
+		if strings.EqualFold(strings.TrimSpace(key), name) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// ParseKeyValuePairs parses key=value arguments into a map. Later pairs override earlier ones.
+func ParseKeyValuePairs(pairs []string) (map[string]string, error) {
+	values := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid value %q: expected key=value", pair)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
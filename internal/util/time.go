@@ -1,6 +1,8 @@
 package util
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -24,3 +26,27 @@ func ParseDate(dateString string) (time.Time, error) {
 	}
 	return time.Time{}, err
 }
+
+// FormatDuration formats a duration as days, hours, and minutes, e.g. "2d 3h 15m".
+// Days are 24 hours. Durations under a minute are shown as "<1m".
+func FormatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return "<1m"
+	}
+
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+	}
+	if minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	return strings.Join(parts, " ")
+}
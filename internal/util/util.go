@@ -1,6 +1,9 @@
 package util
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // TruncateText truncates a string to a maximum length, adding "..." if truncated
 func TruncateText(text string, maxLength int) string {
@@ -18,3 +21,48 @@ func Min(a, b int) int {
 	}
 	return b
 }
+
+// FencedCodeBlock wraps content in a markdown code fence that is longer than
+// any backtick run inside the content, so embedded fences cannot close it early
+func FencedCodeBlock(language, content string) string {
+	longest := 0
+	run := 0
+	for _, r := range content {
+		if r == '`' {
+			run++
+			if run > longest {
+				longest = run
+			}
+		} else {
+			run = 0
+		}
+	}
+
+	fence := strings.Repeat("`", Max(3, longest+1))
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return fence + language + "\n" + content + fence + "\n"
+}
+
+// Max returns the maximum of two integers
+func Max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// FormatBytes formats a byte count in human-readable binary units, e.g. "1.5 MiB"
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for value := n / unit; value >= unit; value /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}